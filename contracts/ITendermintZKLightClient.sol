@@ -5,6 +5,7 @@ interface ITendermintZKLightClient {
     struct ClientState {
         uint64 latestHeight;
         uint64 trustingPeriod;
+        bool frozen;
     }
 
     struct ConsensusState {
@@ -12,6 +12,7 @@ interface ITendermintZKLightClientErrors {
     error ITendermintZKLightClientClientInvalidBlockHash();
     error ITendermintZKLightClientClientInvalidAppHash();
     error ITendermintZKLightClientClientInvalidTimestamp();
+    error ITendermintZKLightClientInvalidProofSpecs();
 
     error ITendermintZKLightClientInvalidUpdateStateMessageInvalidHeight();
     error ITendermintZKLightClientConsensusStateNotFound();
@@ -27,9 +28,14 @@ interface ITendermintZKLightClientErrors {
 
     error ITendermintZKLightClientUnsupportedProtoMessageType();
 
+    error ITendermintZKLightClientInvalidMisbehaviourHeight();
+    error ITendermintZKLightClientInvalidMisbehaviourBlockHash();
+
     error ITendermintZKLightClientUnsupportedProofSpec();
+    error ITendermintZKLightClientUnexpectedProofSpec();
     error ITendermintZKLightClientTendermintSpecInvalidProof();
     error ITendermintZKLightClientIAVLSpecInvalidProof();
+    error ITendermintZKLightClientSMTSpecInvalidProof();
 
     error ITendermintZKLightClientInvalidInnerPrefixLength();
     error ITendermintZKLightClientInvalidInnerSuffixLength();
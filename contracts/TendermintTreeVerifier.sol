@@ -8,7 +8,8 @@ library TendermintTreeVerifier {
     enum ProofSpec {
         None,
         SimpleTree,
-        IAVLTree
+        IAVLTree,
+        SparseMerkleTree
     }
 
     struct InnerOp {
@@ -36,10 +37,22 @@ library TendermintTreeVerifier {
     }
 
     function verifyMembership(ExistenceProof memory proof) internal pure returns (bytes32) {
-        if (proof.spec == ProofSpec.SimpleTree) {
-            return verifyMembershipTendermintSpec(proof.prefix, proof.key, proof.value, proof.path);
-        } else if (proof.spec == ProofSpec.IAVLTree) {
-            return verifyMembershipIAVLSpec(proof.prefix, proof.key, proof.value, proof.path);
+        return verifyMembership(proof.spec, proof.prefix, proof.key, proof.value, proof.path);
+    }
+
+    function verifyMembership(
+        ProofSpec spec,
+        bytes memory prefix,
+        bytes memory key,
+        bytes memory value,
+        InnerOp[] memory path
+    ) internal pure returns (bytes32) {
+        if (spec == ProofSpec.SimpleTree) {
+            return verifyMembershipTendermintSpec(prefix, key, value, path);
+        } else if (spec == ProofSpec.IAVLTree) {
+            return verifyMembershipIAVLSpec(prefix, key, value, path);
+        } else if (spec == ProofSpec.SparseMerkleTree) {
+            return verifyMembershipSMTSpec(prefix, key, value, path);
         } else {
             revert ITendermintZKLightClientErrors.ITendermintZKLightClientUnsupportedProofSpec();
         }
@@ -272,6 +285,50 @@ library TendermintTreeVerifier {
         return h;
     }
 
+    /**
+     * @dev Verify a SMT proof
+     * var SmtSpec = &ProofSpec{
+     *     LeafSpec: &LeafOp{
+     *         Hash:         HashOp_SHA256,
+     *         PrehashKey:   HashOp_SHA256,
+     *         PrehashValue: HashOp_SHA256,
+     *         Length:       LengthOp_NO_PREFIX,
+     *         Prefix:       []byte{0},
+     *     },
+     *     InnerSpec: &InnerSpec{
+     *         ChildOrder:      []int32{0, 1},
+     *         ChildSize:       32,
+     *         MinPrefixLength: 1,
+     *         MaxPrefixLength: 1,
+     *         EmptyChild:      make([]byte, 32),
+     *         Hash:            HashOp_SHA256,
+     *     },
+     * }
+     */
+    function verifyMembershipSMTSpec(bytes memory prefix, bytes memory key, bytes memory value, InnerOp[] memory path)
+        internal
+        pure
+        returns (bytes32)
+    {
+        if (prefix.length != 1 || prefix[0] != 0) {
+            revert ITendermintZKLightClientErrors.ITendermintZKLightClientSMTSpecInvalidProof();
+        }
+        // 1. caclulate the hash of the leaf
+        bytes32 h = sha256(bytes.concat(prefix, sha256(key), sha256(value)));
+        // 2. calculate the hash of the root
+        for (uint256 i = 0; i < path.length; i++) {
+            bytes memory p = path[i].prefix;
+            if (p.length < 1 || p.length > 33) {
+                revert ITendermintZKLightClientErrors.ITendermintZKLightClientSMTSpecInvalidProof();
+            }
+            if (path[i].suffix.length % 32 != 0) {
+                revert ITendermintZKLightClientErrors.ITendermintZKLightClientSMTSpecInvalidProof();
+            }
+            h = sha256(bytes.concat(p, h, path[i].suffix));
+        }
+        return h;
+    }
+
     function prepareLeafData(bytes memory data) internal pure returns (bytes memory) {
         uint256 len = data.length;
         bytes memory bs = new bytes(ProtoBufRuntime._sz_varint(len));
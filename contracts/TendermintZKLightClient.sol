@@ -13,6 +13,7 @@ import {ITendermintZKLightClient} from "./ITendermintZKLightClient.sol";
 import {TendermintZKLightClientProtoMarshaler} from "./TendermintZKLightClientProtoMarshaler.sol";
 import {ITendermintZKLightClientErrors} from "./ITendermintZKLightClientErrors.sol";
 import {TendermintTreeVerifier} from "./TendermintTreeVerifier.sol";
+import {TendermintZKPublicInputs} from "./TendermintZKPublicInputs.sol";
 
 abstract contract TendermintZKLightClient is ITendermintZKLightClient, ITendermintZKLightClientErrors, ILightClient {
     address internal immutable ibcHandler;
@@ -61,6 +62,10 @@ abstract contract TendermintZKLightClient is ITendermintZKLightClient, ITendermi
             revert ITendermintZKLightClientClientInvalidRevisionHeight();
         } else if (clientState.latest_height.revision_number != revisionNumber) {
             revert ITendermintZKLightClientClientInvalidRevisionNumber();
+        } else if (
+            keccak256(abi.encodePacked(clientState.proof_specs)) != keccak256(abi.encodePacked(clientStateProofSpecs()))
+        ) {
+            revert ITendermintZKLightClientInvalidProofSpecs();
         }
 
         if (consensusState.block_hash.length != 32) {
@@ -86,6 +91,34 @@ abstract contract TendermintZKLightClient is ITendermintZKLightClient, ITendermi
         internal
         returns (Height.Data[] memory heights)
     {
+        ClientState storage clientState = clientStates[clientId];
+        if (clientState.frozen) {
+            revert ITendermintZKLightClientClientStateFrozen();
+        }
+        verifyUpdateState(clientId, message);
+
+        ConsensusState storage newConsensusState = consensusStates[clientId][message.untrustedHeight];
+        // a valid proof of another block at a height with a consensus state proves a fork
+        if (newConsensusState.blockHash != bytes32(0)) {
+            if (newConsensusState.blockHash != message.untrustedBlockHash) {
+                clientState.frozen = true;
+            }
+            return heights;
+        }
+        newConsensusState.blockHash = message.untrustedBlockHash;
+        newConsensusState.appHash = message.appHash;
+        newConsensusState.timestamp = message.timestamp;
+
+        heights = new Height.Data[](1);
+        heights[0] = Height.Data({revision_number: revisionNumber, revision_height: message.untrustedHeight});
+        if (clientState.latestHeight < message.untrustedHeight) {
+            clientState.latestHeight = message.untrustedHeight;
+        }
+        return heights;
+    }
+
+    /// @dev Verifies `message` except the zk proof in the same way as `updateState`
+    function verifyUpdateState(string calldata clientId, UpdateStateInput calldata message) internal view {
         if (message.untrustedHeight <= message.trustedHeight) {
             revert ITendermintZKLightClientInvalidUpdateStateMessageInvalidHeight();
         }
@@ -108,8 +141,7 @@ abstract contract TendermintZKLightClient is ITendermintZKLightClient, ITendermi
                 }
                 if (
                     message.input[1]
-                        != uint256(sha256(abi.encodePacked(message.trustedHeight, consensusState.blockHash)))
-                            & ((1 << 253) - 1)
+                        != TendermintZKPublicInputs.stepInputHash(message.trustedHeight, consensusState.blockHash)
                 ) {
                     revert ITendermintZKLightClientZKProofUnexpectedStepInput();
                 }
@@ -120,17 +152,14 @@ abstract contract TendermintZKLightClient is ITendermintZKLightClient, ITendermi
                 }
                 if (
                     message.input[1]
-                        != uint256(
-                            sha256(
-                                abi.encodePacked(message.trustedHeight, consensusState.blockHash, message.untrustedHeight)
-                            )
-                        ) & ((1 << 253) - 1)
+                        != TendermintZKPublicInputs.skipInputHash(
+                            message.trustedHeight, consensusState.blockHash, message.untrustedHeight
+                        )
                 ) {
                     revert ITendermintZKLightClientZKProofUnexpectedSkipInput();
                 }
             }
-            // truncated to 253 bits
-            if (message.input[2] != uint256(sha256(abi.encodePacked(message.untrustedBlockHash))) & ((1 << 253) - 1)) {
+            if (message.input[2] != TendermintZKPublicInputs.outputHash(message.untrustedBlockHash)) {
                 revert ITendermintZKLightClientZKProofUnexpectedOutput();
             }
         }
@@ -141,17 +170,29 @@ abstract contract TendermintZKLightClient is ITendermintZKLightClient, ITendermi
         ) {
             revert ITendermintZKLightClientInvalidSimpleTreeProof();
         }
+    }
 
-        ConsensusState storage newConsensusState = consensusStates[clientId][message.untrustedHeight];
-        newConsensusState.blockHash = message.untrustedBlockHash;
-        newConsensusState.appHash = message.appHash;
-        newConsensusState.timestamp = message.timestamp;
-
-        heights = new Height.Data[](1);
-        heights[0] = Height.Data({revision_number: revisionNumber, revision_height: message.untrustedHeight});
-        if (clientState.latestHeight < message.untrustedHeight) {
-            clientState.latestHeight = message.untrustedHeight;
+    /// @dev Freezes the client with two valid messages that prove different blocks at the same height
+    function submitMisbehaviour(
+        string calldata clientId,
+        UpdateStateInput calldata message1,
+        bytes calldata zkp1,
+        UpdateStateInput calldata message2,
+        bytes calldata zkp2
+    ) public returns (Height.Data[] memory heights) {
+        ClientState storage clientState = clientStates[clientId];
+        if (clientState.frozen) {
+            revert ITendermintZKLightClientClientStateFrozen();
+        } else if (message1.untrustedHeight != message2.untrustedHeight) {
+            revert ITendermintZKLightClientInvalidMisbehaviourHeight();
+        } else if (message1.untrustedBlockHash == message2.untrustedBlockHash) {
+            revert ITendermintZKLightClientInvalidMisbehaviourBlockHash();
         }
+        verifyUpdateState(clientId, message1);
+        verifyZKProof(message1.input, zkp1);
+        verifyUpdateState(clientId, message2);
+        verifyZKProof(message2.input, zkp2);
+        clientState.frozen = true;
         return heights;
     }
 
@@ -169,6 +210,12 @@ abstract contract TendermintZKLightClient is ITendermintZKLightClient, ITendermi
             (UpdateStateInput memory m, bytes memory zkp) =
                 TendermintZKLightClientProtoMarshaler.convertUpdateStateMessage(any.value);
             return routeUpdateState(clientId, m, zkp);
+        } else if (
+            keccak256(bytes(any.type_url)) == TendermintZKLightClientProtoMarshaler.MISBEHAVIOUR_TYPE_URL_KECCAK256
+        ) {
+            (UpdateStateInput memory m1, bytes memory zkp1, UpdateStateInput memory m2, bytes memory zkp2) =
+                TendermintZKLightClientProtoMarshaler.convertMisbehaviour(any.value);
+            return (this.submitMisbehaviour.selector, abi.encode(clientId, m1, zkp1, m2, zkp2));
         } else {
             revert ITendermintZKLightClientUnsupportedProtoMessageType();
         }
@@ -180,6 +227,31 @@ abstract contract TendermintZKLightClient is ITendermintZKLightClient, ITendermi
         virtual
         returns (bytes4 selector, bytes memory args);
 
+    /// @dev Verifies the zk proof of a message in Misbehaviour. `zkp` is encoded as the one passed to `routeUpdateState`.
+    function verifyZKProof(uint256[3] calldata input, bytes calldata zkp) internal view virtual;
+
+    /// @dev The proof specs of the IBC store and the app hash tree. The membership proofs must have these specs
+    /// because the verifier is chosen by the spec in the proof.
+    /// Override it for chains whose IBC store is not an IAVL tree, e.g. a sparse merkle tree.
+    function proofSpecs() internal pure virtual returns (TendermintTreeVerifier.ProofSpec[2] memory specs) {
+        specs[0] = TendermintTreeVerifier.ProofSpec.IAVLTree;
+        specs[1] = TendermintTreeVerifier.ProofSpec.SimpleTree;
+    }
+
+    /// @dev The proof specs in the client state as `proofSpecs()`, which are omitted for the default ones
+    function clientStateProofSpecs() internal pure returns (uint32[] memory specs) {
+        TendermintTreeVerifier.ProofSpec[2] memory expected = proofSpecs();
+        if (
+            expected[0] == TendermintTreeVerifier.ProofSpec.IAVLTree
+                && expected[1] == TendermintTreeVerifier.ProofSpec.SimpleTree
+        ) {
+            return new uint32[](0);
+        }
+        specs = new uint32[](2);
+        specs[0] = uint32(expected[0]);
+        specs[1] = uint32(expected[1]);
+    }
+
     function verifyMembership(
         string calldata clientId,
         Height.Data calldata height,
@@ -193,17 +265,19 @@ abstract contract TendermintZKLightClient is ITendermintZKLightClient, ITendermi
         require(height.revision_number == revisionNumber);
         TendermintTreeVerifier.ExistenceProof[2] memory eproof =
             abi.decode(proof, (TendermintTreeVerifier.ExistenceProof[2]));
-        // require(eproof[0].spec == TendermintTreeVerifier.ProofSpec.IAVLTree);
-        // require(eproof[1].spec == TendermintTreeVerifier.ProofSpec.SimpleTree);
+        TendermintTreeVerifier.ProofSpec[2] memory specs = proofSpecs();
+        if (eproof[0].spec != specs[0] || eproof[1].spec != specs[1]) {
+            revert ITendermintZKLightClientUnexpectedProofSpec();
+        }
         // require(keccak256(eproof[0].key) == keccak256(path));
         // require(keccak256(eproof[0].value) == keccak256(value));
         bytes32 ibcCommitmentRoot =
-            TendermintTreeVerifier.verifyMembershipIAVLSpec(eproof[0].prefix, path, value, eproof[0].path);
+            TendermintTreeVerifier.verifyMembership(specs[0], eproof[0].prefix, path, value, eproof[0].path);
         // require(eproof[1].value.length == 32 && ibcCommitmentRoot == bytes32(eproof[1].value));
         // require(keccak256(eproof[1].key) == keccak256(prefix));
         require(
-            TendermintTreeVerifier.verifyMembershipTendermintSpec(
-                eproof[1].prefix, prefix, abi.encodePacked(ibcCommitmentRoot), eproof[1].path
+            TendermintTreeVerifier.verifyMembership(
+                specs[1], eproof[1].prefix, prefix, abi.encodePacked(ibcCommitmentRoot), eproof[1].path
             ) == consensusStates[clientId][height.revision_height].appHash
         );
         return true;
@@ -221,13 +295,20 @@ abstract contract TendermintZKLightClient is ITendermintZKLightClient, ITendermi
         require(height.revision_number == revisionNumber);
         TendermintTreeVerifier.NonMembershipProof memory p =
             abi.decode(proof, (TendermintTreeVerifier.NonMembershipProof));
+        TendermintTreeVerifier.ProofSpec[2] memory specs = proofSpecs();
+        // only IAVL non-existence proofs are supported
+        if (specs[0] != TendermintTreeVerifier.ProofSpec.IAVLTree) {
+            revert ITendermintZKLightClientUnsupportedProofSpec();
+        } else if (p.proof1.spec != specs[1]) {
+            revert ITendermintZKLightClientUnexpectedProofSpec();
+        }
         require(keccak256(p.proof0.key) == keccak256(path));
         require(keccak256(p.proof1.key) == keccak256(prefix));
         bytes32 ibcCommitmentRoot = TendermintTreeVerifier.verifyNonMembershipIAVL(p.proof0);
         // require(p.proof1.value.length == 32 && ibcCommitmentRoot == bytes32(p.proof1.value));
         require(
-            TendermintTreeVerifier.verifyMembershipTendermintSpec(
-                p.proof1.prefix, prefix, abi.encodePacked(ibcCommitmentRoot), p.proof1.path
+            TendermintTreeVerifier.verifyMembership(
+                specs[1], p.proof1.prefix, prefix, abi.encodePacked(ibcCommitmentRoot), p.proof1.path
             ) == consensusStates[clientId][height.revision_height].appHash
         );
         return true;
@@ -249,11 +330,11 @@ abstract contract TendermintZKLightClient is ITendermintZKLightClient, ITendermi
         latestHeight =
             Height.Data({revision_number: revisionNumber, revision_height: clientStates[clientId].latestHeight});
         latestTimestamp = consensusStates[clientId][latestHeight.revision_height].timestamp;
-        status = ClientStatus.Active;
+        status = getStatus(clientId);
     }
 
-    function getStatus(string calldata) public pure returns (ClientStatus) {
-        return ClientStatus.Active;
+    function getStatus(string calldata clientId) public view returns (ClientStatus) {
+        return clientStates[clientId].frozen ? ClientStatus.Frozen : ClientStatus.Active;
     }
 
     function getClientState(string calldata clientId) public view returns (bytes memory, bool) {
@@ -266,9 +347,10 @@ abstract contract TendermintZKLightClient is ITendermintZKLightClient, ITendermi
                 bytes32(stepVerifierDigest),
                 bytes32(skipVerifierDigest),
                 clientState.trustingPeriod,
-                false,
+                clientState.frozen,
                 revisionNumber,
-                clientState.latestHeight
+                clientState.latestHeight,
+                clientStateProofSpecs()
             ),
             true
         );
@@ -6,7 +6,8 @@ import {GoogleProtobufAny as Any} from "@hyperledger-labs/yui-ibc-solidity/contr
 import {
     IbcLightclientsTendermintzkV1ClientState as ProtoClientState,
     IbcLightclientsTendermintzkV1ConsensusState as ProtoConsensusState,
-    IbcLightclientsTendermintzkV1UpdateStateMessage as ProtoUpdateStateMessage
+    IbcLightclientsTendermintzkV1UpdateStateMessage as ProtoUpdateStateMessage,
+    IbcLightclientsTendermintzkV1Misbehaviour as ProtoMisbehaviour
 } from "./proto/ibc/lightclients/tendermintzk/v1/TendermintZKLightClient.sol";
 import {ITendermintZKLightClient} from "./ITendermintZKLightClient.sol";
 
@@ -18,6 +19,8 @@ library TendermintZKLightClientProtoMarshaler {
 
     string constant UPDATE_STATE_MESSAGE_TYPE_URL = "/ibc.lightclients.tendermintzk.v1.UpdateStateMessage";
     bytes32 constant UPDATE_STATE_MESSAGE_TYPE_URL_KECCAK256 = keccak256(bytes(UPDATE_STATE_MESSAGE_TYPE_URL));
+    string constant MISBEHAVIOUR_TYPE_URL = "/ibc.lightclients.tendermintzk.v1.Misbehaviour";
+    bytes32 constant MISBEHAVIOUR_TYPE_URL_KECCAK256 = keccak256(bytes(MISBEHAVIOUR_TYPE_URL));
 
     function marshal(ProtoClientState.Data memory clientState) public pure returns (bytes memory) {
         bytes memory bz = ProtoClientState.encode(clientState);
@@ -30,7 +33,8 @@ library TendermintZKLightClientProtoMarshaler {
         uint64 trustingPeriod,
         bool frozen,
         uint64 revisionNumber,
-        uint64 revisionHeight
+        uint64 revisionHeight,
+        uint32[] memory proofSpecs
     ) public pure returns (bytes memory) {
         return marshal(
             ProtoClientState.Data({
@@ -38,7 +42,8 @@ library TendermintZKLightClientProtoMarshaler {
                 skip_verifier_digest: abi.encodePacked(skipVerifierDigest),
                 trusting_period: trustingPeriod,
                 frozen: frozen,
-                latest_height: Height.Data({revision_number: revisionNumber, revision_height: revisionHeight})
+                latest_height: Height.Data({revision_number: revisionNumber, revision_height: revisionHeight}),
+                proof_specs: proofSpecs
             })
         );
     }
@@ -88,7 +93,29 @@ library TendermintZKLightClientProtoMarshaler {
         pure
         returns (ITendermintZKLightClient.UpdateStateInput memory, bytes memory)
     {
-        ProtoUpdateStateMessage.Data memory protoMessage = ProtoUpdateStateMessage.decode(protoMessageBytes);
+        return toUpdateStateInput(ProtoUpdateStateMessage.decode(protoMessageBytes));
+    }
+
+    function convertMisbehaviour(bytes memory protoMisbehaviourBytes)
+        public
+        pure
+        returns (
+            ITendermintZKLightClient.UpdateStateInput memory message1,
+            bytes memory zkp1,
+            ITendermintZKLightClient.UpdateStateInput memory message2,
+            bytes memory zkp2
+        )
+    {
+        ProtoMisbehaviour.Data memory protoMisbehaviour = ProtoMisbehaviour.decode(protoMisbehaviourBytes);
+        (message1, zkp1) = toUpdateStateInput(protoMisbehaviour.update_state_1);
+        (message2, zkp2) = toUpdateStateInput(protoMisbehaviour.update_state_2);
+    }
+
+    function toUpdateStateInput(ProtoUpdateStateMessage.Data memory protoMessage)
+        internal
+        pure
+        returns (ITendermintZKLightClient.UpdateStateInput memory, bytes memory)
+    {
         require(protoMessage.input.length == 3);
         uint256[3] memory publicInput;
         for (uint256 i = 0; i < 3; i++) {
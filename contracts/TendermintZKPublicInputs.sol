@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+pragma solidity ^0.8.20;
+
+/// @dev The public inputs of the TendermintX circuits. The hashes are truncated to 253 bits to fit in the scalar field.
+/// They are computed in the same way by the `inputs` package of the relayer and the gnark prover.
+library TendermintZKPublicInputs {
+    uint256 internal constant HASH_MASK = (1 << 253) - 1;
+
+    /// @dev inputHash of the step circuit that proves the header at `trustedHeight + 1`
+    function stepInputHash(uint64 trustedHeight, bytes32 trustedBlockHash) internal pure returns (uint256) {
+        return uint256(sha256(abi.encodePacked(trustedHeight, trustedBlockHash))) & HASH_MASK;
+    }
+
+    /// @dev inputHash of the skip circuit that proves the header at `targetHeight`
+    function skipInputHash(uint64 trustedHeight, bytes32 trustedBlockHash, uint64 targetHeight)
+        internal
+        pure
+        returns (uint256)
+    {
+        return uint256(sha256(abi.encodePacked(trustedHeight, trustedBlockHash, targetHeight))) & HASH_MASK;
+    }
+
+    /// @dev inputHash of the step circuit if `targetHeight` is next to `trustedHeight`, otherwise of the skip circuit
+    function inputHash(uint64 trustedHeight, bytes32 trustedBlockHash, uint64 targetHeight)
+        internal
+        pure
+        returns (uint256)
+    {
+        if (targetHeight - trustedHeight == 1) {
+            return stepInputHash(trustedHeight, trustedBlockHash);
+        }
+        return skipInputHash(trustedHeight, trustedBlockHash, targetHeight);
+    }
+
+    /// @dev outputHash of both circuits
+    function outputHash(bytes32 untrustedBlockHash) internal pure returns (uint256) {
+        return uint256(sha256(abi.encodePacked(untrustedBlockHash))) & HASH_MASK;
+    }
+}
@@ -28,4 +28,8 @@ contract TendermintZKLightClientGroth16 is TendermintZKLightClient, Verifier {
         verifyProof(proof, message.input);
         return updateState(clientId, message);
     }
+
+    function verifyZKProof(uint256[3] calldata input, bytes calldata zkp) internal view virtual override {
+        this.verifyProof(abi.decode(zkp, (uint256[8])), input);
+    }
 }
@@ -34,4 +34,10 @@ contract TendermintZKLightClientGroth16Commitment is TendermintZKLightClient, Ve
         verifyProof(proof, commitments, commitmentPok, message.input);
         return updateState(clientId, message);
     }
+
+    function verifyZKProof(uint256[3] calldata input, bytes calldata zkp) internal view virtual override {
+        (uint256[8] memory proof, uint256[2] memory commitments, uint256[2] memory commitmentPok) =
+            abi.decode(zkp, (uint256[8], uint256[2], uint256[2]));
+        this.verifyProof(proof, commitments, commitmentPok, input);
+    }
 }
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+pragma solidity ^0.8.20;
+
+import {Height} from "@hyperledger-labs/yui-ibc-solidity/contracts/proto/Client.sol";
+import {TendermintZKLightClient} from "../TendermintZKLightClient.sol";
+import {Verifier} from "./Groth16Verifier.sol";
+
+/**
+ * @dev TendermintZKLightClientGroth16Compressed accepts the Groth16 proofs whose points are compressed by `Verifier.compressProof`.
+ * It halves the calldata of the proof at the cost of the point decompression.
+ */
+contract TendermintZKLightClientGroth16Compressed is TendermintZKLightClient, Verifier {
+    constructor(address ibcHandler_, uint256 stepVerifierDigest_, uint256 skipVerifierDigest_, uint64 revisionNumber_)
+        TendermintZKLightClient(ibcHandler_, stepVerifierDigest_, skipVerifierDigest_, revisionNumber_)
+    {}
+
+    function routeUpdateState(string calldata clientId, UpdateStateInput memory m, bytes memory zkp)
+        public
+        pure
+        virtual
+        override
+        returns (bytes4 selector, bytes memory args)
+    {
+        return (this.updateStateGroth16Compressed.selector, abi.encode(clientId, m, abi.decode(zkp, (uint256[4]))));
+    }
+
+    function updateStateGroth16Compressed(
+        string calldata clientId,
+        UpdateStateInput calldata message,
+        uint256[4] calldata compressedProof
+    ) public virtual returns (Height.Data[] memory heights) {
+        verifyCompressedProof(compressedProof, message.input);
+        return updateState(clientId, message);
+    }
+
+    function verifyZKProof(uint256[3] calldata input, bytes calldata zkp) internal view virtual override {
+        this.verifyCompressedProof(abi.decode(zkp, (uint256[4])), input);
+    }
+}
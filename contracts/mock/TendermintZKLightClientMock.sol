@@ -27,4 +27,8 @@ contract TendermintZKLightClientMock is TendermintZKLightClient {
         require(keccak256(proof) == keccak256("mock"), "proof not valid");
         return updateState(clientId, message);
     }
+
+    function verifyZKProof(uint256[3] calldata input, bytes calldata zkp) internal view virtual override {
+        require(keccak256(zkp) == keccak256("mock"), "proof not valid");
+    }
 }
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+pragma solidity ^0.8.20;
+
+import {Height} from "@hyperledger-labs/yui-ibc-solidity/contracts/proto/Client.sol";
+import {TendermintZKLightClient} from "../TendermintZKLightClient.sol";
+import {MockGroth16Verifier} from "./MockGroth16Verifier.sol";
+
+/// @notice It verifies Groth16 proofs of the mock circuit generated by the relayer with `prover_type = "mock-groth16"`.
+/// The circuit only binds the public inputs, so this contract must be used for testing only.
+contract TendermintZKLightClientMockGroth16 is TendermintZKLightClient, MockGroth16Verifier {
+    constructor(address ibcHandler_, uint256 stepVerifierDigest_, uint256 skipVerifierDigest_, uint64 revisionNumber_)
+        TendermintZKLightClient(ibcHandler_, stepVerifierDigest_, skipVerifierDigest_, revisionNumber_)
+    {}
+
+    function routeUpdateState(string calldata clientId, UpdateStateInput memory m, bytes memory zkp)
+        public
+        pure
+        virtual
+        override
+        returns (bytes4 selector, bytes memory args)
+    {
+        return (this.updateStateMockGroth16.selector, abi.encode(clientId, m, abi.decode(zkp, (uint256[8]))));
+    }
+
+    function updateStateMockGroth16(
+        string calldata clientId,
+        UpdateStateInput calldata message,
+        uint256[8] calldata proof
+    ) public virtual returns (Height.Data[] memory heights) {
+        verifyProof(proof, message.input);
+        return updateState(clientId, message);
+    }
+
+    function verifyZKProof(uint256[3] calldata input, bytes calldata zkp) internal view virtual override {
+        this.verifyProof(abi.decode(zkp, (uint256[8])), input);
+    }
+}
@@ -28,4 +28,8 @@ contract TendermintZKLightClientPlonk is TendermintZKLightClient, PlonkVerifier
         require(Verify(proof, message.input));
         return updateState(clientId, message);
     }
+
+    function verifyZKProof(uint256[3] calldata input, bytes calldata zkp) internal view virtual override {
+        require(this.Verify(abi.decode(zkp, (bytes)), input));
+    }
 }
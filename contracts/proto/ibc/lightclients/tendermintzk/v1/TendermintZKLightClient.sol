@@ -14,6 +14,7 @@ library IbcLightclientsTendermintzkV1ClientState {
     uint64 trusting_period;
     bool frozen;
     Height.Data latest_height;
+    uint32[] proof_specs;
   }
 
   // Decoder section
@@ -76,6 +77,9 @@ library IbcLightclientsTendermintzkV1ClientState {
       if (fieldId == 5) {
         pointer += _read_latest_height(pointer, bs, r);
       } else
+      if (fieldId == 6) {
+        pointer += _read_packed_repeated_proof_specs(pointer, bs, r);
+      } else
       {
         pointer += ProtoBufRuntime._skip_field_decode(wireType, pointer, bs);
       }
@@ -171,6 +175,30 @@ library IbcLightclientsTendermintzkV1ClientState {
     return sz;
   }
 
+  /**
+   * @dev The decoder for reading a field
+   * @param p The offset of bytes array to start decode
+   * @param bs The bytes array to be decoded
+   * @param r The in-memory struct
+   * @return The number of bytes decoded
+   */
+  function _read_packed_repeated_proof_specs(
+    uint256 p,
+    bytes memory bs,
+    Data memory r
+  ) internal pure returns (uint) {
+    (uint256 len, uint256 size) = ProtoBufRuntime._decode_varint(p, bs);
+    p += size;
+    uint256 count = ProtoBufRuntime._count_packed_repeated_varint(p, len, bs);
+    r.proof_specs = new uint32[](count);
+    for (uint256 i = 0; i < count; i++) {
+      (uint32 x, uint256 sz) = ProtoBufRuntime._decode_uint32(p, bs);
+      p += sz;
+      r.proof_specs[i] = x;
+    }
+    return size + len;
+  }
+
   // struct decoder
   /**
    * @dev The decoder for reading a inner struct field
@@ -223,7 +251,7 @@ library IbcLightclientsTendermintzkV1ClientState {
   {
     uint256 offset = p;
     uint256 pointer = p;
-    
+    uint256 i;
     if (r.step_verifier_digest.length != 0) {
     pointer += ProtoBufRuntime._encode_key(
       1,
@@ -269,6 +297,22 @@ library IbcLightclientsTendermintzkV1ClientState {
     );
     pointer += Height._encode_nested(r.latest_height, pointer, bs);
     
+    if (r.proof_specs.length != 0) {
+    pointer += ProtoBufRuntime._encode_key(
+      6,
+      ProtoBufRuntime.WireType.LengthDelim,
+      pointer,
+      bs
+    );
+    pointer += ProtoBufRuntime._encode_varint(
+      ProtoBufRuntime._estimate_packed_repeated_uint32(r.proof_specs),
+      pointer,
+      bs
+    );
+    for(i = 0; i < r.proof_specs.length; i++) {
+      pointer += ProtoBufRuntime._encode_uint32(r.proof_specs[i], pointer, bs);
+    }
+    }
     return pointer - offset;
   }
   // nested encoder
@@ -317,6 +361,7 @@ library IbcLightclientsTendermintzkV1ClientState {
     e += 1 + ProtoBufRuntime._sz_uint64(r.trusting_period);
     e += 1 + 1;
     e += 1 + ProtoBufRuntime._sz_lendelim(Height._estimate(r.latest_height));
+    e += 1 + ProtoBufRuntime._sz_lendelim(ProtoBufRuntime._estimate_packed_repeated_uint32(r.proof_specs));
     return e;
   }
   // empty checker
@@ -341,6 +386,10 @@ library IbcLightclientsTendermintzkV1ClientState {
     return false;
   }
 
+  if (r.proof_specs.length != 0) {
+    return false;
+  }
+
     return true;
   }
 
@@ -357,10 +406,29 @@ library IbcLightclientsTendermintzkV1ClientState {
     output.trusting_period = input.trusting_period;
     output.frozen = input.frozen;
     Height.store(input.latest_height, output.latest_height);
+    output.proof_specs = input.proof_specs;
 
   }
 
 
+  //array helpers for ProofSpecs
+  /**
+   * @dev Add value to an array
+   * @param self The in-memory struct
+   * @param value The value to add
+   */
+  function addProofSpecs(Data memory self, uint32 value) internal pure {
+    /**
+     * First resize the array. Then add the new element to the end.
+     */
+    uint32[] memory tmp = new uint32[](self.proof_specs.length + 1);
+    for (uint256 i = 0; i < self.proof_specs.length; i++) {
+      tmp[i] = self.proof_specs[i];
+    }
+    tmp[self.proof_specs.length] = value;
+    self.proof_specs = tmp;
+  }
+
 
   //utility functions
   /**
@@ -1242,6 +1310,276 @@ library IbcLightclientsTendermintzkV1UpdateStateMessage {
 }
 //library IbcLightclientsTendermintzkV1UpdateStateMessage
 
+library IbcLightclientsTendermintzkV1Misbehaviour {
+
+
+  //struct definition
+  struct Data {
+    IbcLightclientsTendermintzkV1UpdateStateMessage.Data update_state_1;
+    IbcLightclientsTendermintzkV1UpdateStateMessage.Data update_state_2;
+  }
+
+  // Decoder section
+
+  /**
+   * @dev The main decoder for memory
+   * @param bs The bytes array to be decoded
+   * @return The decoded struct
+   */
+  function decode(bytes memory bs) internal pure returns (Data memory) {
+    (Data memory x, ) = _decode(32, bs, bs.length);
+    return x;
+  }
+
+  /**
+   * @dev The main decoder for storage
+   * @param self The in-storage struct
+   * @param bs The bytes array to be decoded
+   */
+  function decode(Data storage self, bytes memory bs) internal {
+    (Data memory x, ) = _decode(32, bs, bs.length);
+    store(x, self);
+  }
+  // inner decoder
+
+  /**
+   * @dev The decoder for internal usage
+   * @param p The offset of bytes array to start decode
+   * @param bs The bytes array to be decoded
+   * @param sz The number of bytes expected
+   * @return The decoded struct
+   * @return The number of bytes decoded
+   */
+  function _decode(uint256 p, bytes memory bs, uint256 sz)
+    internal
+    pure
+    returns (Data memory, uint)
+  {
+    Data memory r;
+    uint256 fieldId;
+    ProtoBufRuntime.WireType wireType;
+    uint256 bytesRead;
+    uint256 offset = p;
+    uint256 pointer = p;
+    while (pointer < offset + sz) {
+      (fieldId, wireType, bytesRead) = ProtoBufRuntime._decode_key(pointer, bs);
+      pointer += bytesRead;
+      if (fieldId == 1) {
+        pointer += _read_update_state_1(pointer, bs, r);
+      } else
+      if (fieldId == 2) {
+        pointer += _read_update_state_2(pointer, bs, r);
+      } else
+      {
+        pointer += ProtoBufRuntime._skip_field_decode(wireType, pointer, bs);
+      }
+
+    }
+    return (r, sz);
+  }
+
+  // field readers
+
+  /**
+   * @dev The decoder for reading a field
+   * @param p The offset of bytes array to start decode
+   * @param bs The bytes array to be decoded
+   * @param r The in-memory struct
+   * @return The number of bytes decoded
+   */
+  function _read_update_state_1(
+    uint256 p,
+    bytes memory bs,
+    Data memory r
+  ) internal pure returns (uint) {
+    (IbcLightclientsTendermintzkV1UpdateStateMessage.Data memory x, uint256 sz) = _decode_IbcLightclientsTendermintzkV1UpdateStateMessage(p, bs);
+    r.update_state_1 = x;
+    return sz;
+  }
+
+  /**
+   * @dev The decoder for reading a field
+   * @param p The offset of bytes array to start decode
+   * @param bs The bytes array to be decoded
+   * @param r The in-memory struct
+   * @return The number of bytes decoded
+   */
+  function _read_update_state_2(
+    uint256 p,
+    bytes memory bs,
+    Data memory r
+  ) internal pure returns (uint) {
+    (IbcLightclientsTendermintzkV1UpdateStateMessage.Data memory x, uint256 sz) = _decode_IbcLightclientsTendermintzkV1UpdateStateMessage(p, bs);
+    r.update_state_2 = x;
+    return sz;
+  }
+
+  // struct decoder
+  /**
+   * @dev The decoder for reading a inner struct field
+   * @param p The offset of bytes array to start decode
+   * @param bs The bytes array to be decoded
+   * @return The decoded inner-struct
+   * @return The number of bytes used to decode
+   */
+  function _decode_IbcLightclientsTendermintzkV1UpdateStateMessage(uint256 p, bytes memory bs)
+    internal
+    pure
+    returns (IbcLightclientsTendermintzkV1UpdateStateMessage.Data memory, uint)
+  {
+    uint256 pointer = p;
+    (uint256 sz, uint256 bytesRead) = ProtoBufRuntime._decode_varint(pointer, bs);
+    pointer += bytesRead;
+    (IbcLightclientsTendermintzkV1UpdateStateMessage.Data memory r, ) = IbcLightclientsTendermintzkV1UpdateStateMessage._decode(pointer, bs, sz);
+    return (r, sz + bytesRead);
+  }
+
+
+  // Encoder section
+
+  /**
+   * @dev The main encoder for memory
+   * @param r The struct to be encoded
+   * @return The encoded byte array
+   */
+  function encode(Data memory r) internal pure returns (bytes memory) {
+    bytes memory bs = new bytes(_estimate(r));
+    uint256 sz = _encode(r, 32, bs);
+    assembly {
+      mstore(bs, sz)
+    }
+    return bs;
+  }
+  // inner encoder
+
+  /**
+   * @dev The encoder for internal usage
+   * @param r The struct to be encoded
+   * @param p The offset of bytes array to start decode
+   * @param bs The bytes array to be decoded
+   * @return The number of bytes encoded
+   */
+  function _encode(Data memory r, uint256 p, bytes memory bs)
+    internal
+    pure
+    returns (uint)
+  {
+    uint256 offset = p;
+    uint256 pointer = p;
+    
+    
+    pointer += ProtoBufRuntime._encode_key(
+      1,
+      ProtoBufRuntime.WireType.LengthDelim,
+      pointer,
+      bs
+    );
+    pointer += IbcLightclientsTendermintzkV1UpdateStateMessage._encode_nested(r.update_state_1, pointer, bs);
+    
+    
+    pointer += ProtoBufRuntime._encode_key(
+      2,
+      ProtoBufRuntime.WireType.LengthDelim,
+      pointer,
+      bs
+    );
+    pointer += IbcLightclientsTendermintzkV1UpdateStateMessage._encode_nested(r.update_state_2, pointer, bs);
+    
+    return pointer - offset;
+  }
+  // nested encoder
+
+  /**
+   * @dev The encoder for inner struct
+   * @param r The struct to be encoded
+   * @param p The offset of bytes array to start decode
+   * @param bs The bytes array to be decoded
+   * @return The number of bytes encoded
+   */
+  function _encode_nested(Data memory r, uint256 p, bytes memory bs)
+    internal
+    pure
+    returns (uint)
+  {
+    /**
+     * First encoded `r` into a temporary array, and encode the actual size used.
+     * Then copy the temporary array into `bs`.
+     */
+    uint256 offset = p;
+    uint256 pointer = p;
+    bytes memory tmp = new bytes(_estimate(r));
+    uint256 tmpAddr = ProtoBufRuntime.getMemoryAddress(tmp);
+    uint256 bsAddr = ProtoBufRuntime.getMemoryAddress(bs);
+    uint256 size = _encode(r, 32, tmp);
+    pointer += ProtoBufRuntime._encode_varint(size, pointer, bs);
+    ProtoBufRuntime.copyBytes(tmpAddr + 32, bsAddr + pointer, size);
+    pointer += size;
+    delete tmp;
+    return pointer - offset;
+  }
+  // estimator
+
+  /**
+   * @dev The estimator for a struct
+   * @param r The struct to be encoded
+   * @return The number of bytes encoded in estimation
+   */
+  function _estimate(
+    Data memory r
+  ) internal pure returns (uint) {
+    uint256 e;
+    e += 1 + ProtoBufRuntime._sz_lendelim(IbcLightclientsTendermintzkV1UpdateStateMessage._estimate(r.update_state_1));
+    e += 1 + ProtoBufRuntime._sz_lendelim(IbcLightclientsTendermintzkV1UpdateStateMessage._estimate(r.update_state_2));
+    return e;
+  }
+  // empty checker
+
+  function _empty(
+    Data memory r
+  ) internal pure returns (bool) {
+    
+    return true;
+  }
+
+
+  //store function
+  /**
+   * @dev Store in-memory struct to storage
+   * @param input The in-memory struct
+   * @param output The in-storage struct
+   */
+  function store(Data memory input, Data storage output) internal {
+    IbcLightclientsTendermintzkV1UpdateStateMessage.store(input.update_state_1, output.update_state_1);
+    IbcLightclientsTendermintzkV1UpdateStateMessage.store(input.update_state_2, output.update_state_2);
+
+  }
+
+
+
+  //utility functions
+  /**
+   * @dev Return an empty struct
+   * @return r The empty struct
+   */
+  function nil() internal pure returns (Data memory r) {
+    assembly {
+      r := 0
+    }
+  }
+
+  /**
+   * @dev Test whether a struct is empty
+   * @param x The struct to be tested
+   * @return r True if it is empty
+   */
+  function isNil(Data memory x) internal pure returns (bool r) {
+    assembly {
+      r := iszero(x)
+    }
+  }
+}
+//library IbcLightclientsTendermintzkV1Misbehaviour
+
 library IbcLightclientsTendermintzkV1Timestamp {
 
 
@@ -18,5 +18,7 @@ import {ICS20Bank} from "@hyperledger-labs/yui-ibc-solidity/contracts/apps/20-tr
 import {ICS20TransferBank} from "@hyperledger-labs/yui-ibc-solidity/contracts/apps/20-transfer/ICS20TransferBank.sol";
 import {TendermintZKLightClientGroth16} from "tendermint-zk-lc/contracts/groth16/TendermintZKLightClientGroth16.sol";
 import {TendermintZKLightClientGroth16Commitment} from "tendermint-zk-lc/contracts/groth16/TendermintZKLightClientGroth16Commitment.sol";
+import {TendermintZKLightClientGroth16Compressed} from "tendermint-zk-lc/contracts/groth16/TendermintZKLightClientGroth16Compressed.sol";
 import {TendermintZKLightClientMock} from "tendermint-zk-lc/contracts/mock/TendermintZKLightClientMock.sol";
+import {TendermintZKLightClientMockGroth16} from "tendermint-zk-lc/contracts/mock/TendermintZKLightClientMockGroth16.sol";
 import {TendermintZKLightClientProtoMarshaler} from "tendermint-zk-lc/contracts/TendermintZKLightClientProtoMarshaler.sol";
\ No newline at end of file
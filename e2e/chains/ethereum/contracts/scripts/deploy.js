@@ -39,10 +39,14 @@ async function main() {
   let tendermintZKLightClient;
   if (process.env.TM_ZK_PS === "groth16") {
     tendermintZKLightClient = "TendermintZKLightClientGroth16";
+  } else if (process.env.TM_ZK_PS === "groth16-compressed") {
+    tendermintZKLightClient = "TendermintZKLightClientGroth16Compressed";
   } else if (process.env.TM_ZK_PS === "groth16-commitment") {
     tendermintZKLightClient = "TendermintZKLightClientGroth16Commitment";
   } else if (process.env.TM_ZK_PS === "mock") {
     tendermintZKLightClient = "TendermintZKLightClientMock";
+  } else if (process.env.TM_ZK_PS === "mock-groth16") {
+    tendermintZKLightClient = "TendermintZKLightClientMockGroth16";
   } else {
     throw new Error("Unknown env value `TM_ZK_PS`:" + process.env.TM_ZK_PS);
   }
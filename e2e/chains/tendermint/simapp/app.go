@@ -125,7 +125,7 @@ import (
 
 	mockclient "github.com/datachainlab/ibc-mock-client/modules/light-clients/xx-mock"
 	mockclienttypes "github.com/datachainlab/ibc-mock-client/modules/light-clients/xx-mock/types"
-	tendermintzk "github.com/datachainlab/tendermint-zk-ibc/e2e/chains/tendermint/simapp/relay"
+	"github.com/datachainlab/tendermint-zk-ibc/e2e/chains/tendermint/simapp/tendermintzk"
 )
 
 const appName = "SimApp"
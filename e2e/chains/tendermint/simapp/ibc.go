@@ -15,7 +15,7 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
-	tendermintzk "github.com/datachainlab/tendermint-zk-ibc/e2e/chains/tendermint/simapp/relay"
+	tendermintzk "github.com/datachainlab/tendermint-zk-ibc/go/relay"
 )
 
 func overrideIBCClientKeeper(k ibckeeper.Keeper, cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace, stakingKeeper *stakingkeeper.Keeper) *ibckeeper.Keeper {
@@ -16,6 +16,10 @@
     "step_verifier_digest": "0x09bf185e9e478bac323981a844afe484dcd73823f6a34f5adb8cffe6c4436111",
     "skip_verifier_digest": "0x286fd609266936f71d552671b7553f1a0e59c7cf296112996bded1ca3bafa4a4",
     "prover_type": "",
-    "trusting_period": "336h"
+    "trusting_period": "336h",
+    "refresh_threshold_rate": {
+      "numerator": 2,
+      "denominator": 3
+    }
   }
 }
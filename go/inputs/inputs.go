@@ -0,0 +1,103 @@
+// Package inputs computes the public inputs of the step and skip circuits in the same way as `TendermintZKLightClient.updateState`.
+package inputs
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+)
+
+const (
+	// verifierDigest,inputHash,outputHash
+	NbPublicInputs = 3
+	// the input hash and the output hash are truncated to fit in the scalar field of BN254
+	HashBits = 253
+)
+
+// PublicInputs are the public inputs of the step or skip circuit
+//   - [0]: the verifier digest of the step circuit if `targetHeight == trustedHeight + 1`, otherwise the skip circuit
+//   - [1]: the input hash, `StepInputHash` or `SkipInputHash`
+//   - [2]: the output hash, `OutputHash`
+type PublicInputs [NbPublicInputs][32]byte
+
+// Compute returns the public inputs of the update from `trustedHeight` to `targetHeight`
+func Compute(stepVerifierDigest, skipVerifierDigest []byte, trustedHeight uint64, trustedBlockHash []byte, targetHeight uint64, untrustedBlockHash []byte) PublicInputs {
+	var input PublicInputs
+	if IsStep(trustedHeight, targetHeight) {
+		copy(input[0][:], stepVerifierDigest)
+		input[1] = StepInputHash(trustedHeight, trustedBlockHash)
+	} else {
+		copy(input[0][:], skipVerifierDigest)
+		input[1] = SkipInputHash(trustedHeight, trustedBlockHash, targetHeight)
+	}
+	input[2] = OutputHash(untrustedBlockHash)
+	return input
+}
+
+// IsStep returns true if the update is proven by the step circuit
+func IsStep(trustedHeight, targetHeight uint64) bool {
+	return targetHeight-trustedHeight == 1
+}
+
+// StepInputHash returns sha256(trustedHeight || trustedBlockHash) truncated to 253 bits
+func StepInputHash(trustedHeight uint64, trustedBlockHash []byte) [32]byte {
+	var bz [40]byte
+	binary.BigEndian.PutUint64(bz[:8], trustedHeight)
+	copy(bz[8:], trustedBlockHash)
+	return truncatedHash(bz[:])
+}
+
+// SkipInputHash returns sha256(trustedHeight || trustedBlockHash || targetHeight) truncated to 253 bits
+func SkipInputHash(trustedHeight uint64, trustedBlockHash []byte, targetHeight uint64) [32]byte {
+	var bz [48]byte
+	binary.BigEndian.PutUint64(bz[:8], trustedHeight)
+	copy(bz[8:], trustedBlockHash)
+	binary.BigEndian.PutUint64(bz[40:], targetHeight)
+	return truncatedHash(bz[:])
+}
+
+// OutputHash returns sha256(untrustedBlockHash) truncated to 253 bits
+func OutputHash(untrustedBlockHash []byte) [32]byte {
+	var bz [32]byte
+	copy(bz[:], untrustedBlockHash)
+	return truncatedHash(bz[:])
+}
+
+func truncatedHash(bz []byte) [32]byte {
+	h := sha256.Sum256(bz)
+	h[0] &= 0x1f
+	return h
+}
+
+// BigInts returns the public inputs as the field elements passed to the verifier
+func (pi PublicInputs) BigInts() [NbPublicInputs]*big.Int {
+	var res [NbPublicInputs]*big.Int
+	for i := range pi {
+		res[i] = new(big.Int).SetBytes(pi[i][:])
+	}
+	return res
+}
+
+// DecodePlonky2PublicInputs returns the input hash and the output hash in the public inputs of the plonky2 proof, which are 64 bytes each given as a field element
+func DecodePlonky2PublicInputs(publicInputs []uint64) (inputHash *big.Int, outputHash *big.Int, err error) {
+	if len(publicInputs) != 64 {
+		return nil, nil, fmt.Errorf("publicInputs must be 64 bytes: actual=%d", len(publicInputs))
+	}
+	bz := make([]byte, 64)
+	for i, v := range publicInputs {
+		if v > 0xff {
+			return nil, nil, fmt.Errorf("publicInputs[%d] is not a byte: %d", i, v)
+		}
+		bz[i] = byte(v)
+	}
+	inputHash = new(big.Int).SetBytes(bz[:32])
+	outputHash = new(big.Int).SetBytes(bz[32:])
+	if inputHash.BitLen() > HashBits {
+		return nil, nil, fmt.Errorf("inputHash must be at most %d bits", HashBits)
+	}
+	if outputHash.BitLen() > HashBits {
+		return nil, nil, fmt.Errorf("outputHash must be at most %d bits", HashBits)
+	}
+	return inputHash, outputHash, nil
+}
@@ -0,0 +1,97 @@
+package inputs
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// vector is a test vector shared with `PublicInputs.t.sol`
+type vector struct {
+	Name               string        `json:"name"`
+	TrustedHeight      uint64        `json:"trustedHeight"`
+	TrustedBlockHash   hexutil.Bytes `json:"trustedBlockHash"`
+	TargetHeight       uint64        `json:"targetHeight"`
+	UntrustedBlockHash hexutil.Bytes `json:"untrustedBlockHash"`
+	InputHash          hexutil.Bytes `json:"inputHash"`
+	OutputHash         hexutil.Bytes `json:"outputHash"`
+}
+
+func readVectors(t *testing.T) []vector {
+	bz, err := os.ReadFile("../../test/data/public_inputs.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var data struct {
+		Vectors []vector `json:"vectors"`
+	}
+	if err := json.Unmarshal(bz, &data); err != nil {
+		t.Fatal(err)
+	}
+	if len(data.Vectors) == 0 {
+		t.Fatal("no test vectors")
+	}
+	return data.Vectors
+}
+
+func TestCompute(t *testing.T) {
+	stepDigest, skipDigest := bytes.Repeat([]byte{0x01}, 32), bytes.Repeat([]byte{0x02}, 32)
+	for _, v := range readVectors(t) {
+		input := Compute(stepDigest, skipDigest, v.TrustedHeight, v.TrustedBlockHash, v.TargetHeight, v.UntrustedBlockHash)
+		expectedDigest := skipDigest
+		if v.TargetHeight == v.TrustedHeight+1 {
+			expectedDigest = stepDigest
+		}
+		if !bytes.Equal(input[0][:], expectedDigest) {
+			t.Errorf("%s: unexpected verifier digest: %x", v.Name, input[0])
+		}
+		if !bytes.Equal(input[1][:], v.InputHash) {
+			t.Errorf("%s: unexpected input hash: %x", v.Name, input[1])
+		}
+		if !bytes.Equal(input[2][:], v.OutputHash) {
+			t.Errorf("%s: unexpected output hash: %x", v.Name, input[2])
+		}
+		for i, in := range input.BigInts() {
+			if i > 0 && in.BitLen() > HashBits {
+				t.Errorf("%s: input[%d] is not truncated: %x", v.Name, i, in)
+			}
+		}
+	}
+}
+
+func TestDecodePlonky2PublicInputs(t *testing.T) {
+	for _, v := range readVectors(t) {
+		var pis []uint64
+		for _, b := range append(append([]byte{}, v.InputHash...), v.OutputHash...) {
+			pis = append(pis, uint64(b))
+		}
+		inputHash, outputHash, err := DecodePlonky2PublicInputs(pis)
+		if err != nil {
+			t.Errorf("%s: unexpected result: %v", v.Name, err)
+			continue
+		}
+		if !bytes.Equal(inputHash.FillBytes(make([]byte, 32)), v.InputHash) || !bytes.Equal(outputHash.FillBytes(make([]byte, 32)), v.OutputHash) {
+			t.Errorf("%s: unexpected hashes: input=%x output=%x", v.Name, inputHash, outputHash)
+		}
+	}
+
+	valid := make([]uint64, 64)
+	cases := []struct {
+		name   string
+		modify func(pis []uint64) []uint64
+	}{
+		{"short", func(pis []uint64) []uint64 { return pis[:63] }},
+		{"not a byte", func(pis []uint64) []uint64 { pis[10] = 0x100; return pis }},
+		{"input hash over 253 bits", func(pis []uint64) []uint64 { pis[0] = 0x20; return pis }},
+		{"output hash over 253 bits", func(pis []uint64) []uint64 { pis[32] = 0xff; return pis }},
+	}
+	for _, c := range cases {
+		pis := c.modify(append([]uint64{}, valid...))
+		if _, _, err := DecodePlonky2PublicInputs(pis); err == nil {
+			t.Errorf("%s: expected an error", c.name)
+		}
+	}
+}
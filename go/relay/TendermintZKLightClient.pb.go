@@ -30,6 +30,8 @@ type ClientState struct {
 	TrustingPeriod     uint64       `protobuf:"varint,3,opt,name=trusting_period,json=trustingPeriod,proto3" json:"trusting_period,omitempty"`
 	Frozen             bool         `protobuf:"varint,4,opt,name=frozen,proto3" json:"frozen,omitempty"`
 	LatestHeight       types.Height `protobuf:"bytes,5,opt,name=latest_height,json=latestHeight,proto3" json:"latest_height"`
+	// the specs of the IBC store and the app hash tree as `TendermintTreeVerifier.ProofSpec` (default: IAVL and simple tree)
+	ProofSpecs []uint32 `protobuf:"varint,6,rep,packed,name=proof_specs,json=proofSpecs,proto3" json:"proof_specs,omitempty"`
 }
 
 func (m *ClientState) Reset()         { *m = ClientState{} }
@@ -148,6 +150,44 @@ func (m *UpdateStateMessage) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateStateMessage proto.InternalMessageInfo
 
+type Misbehaviour struct {
+	UpdateState1 *UpdateStateMessage `protobuf:"bytes,1,opt,name=update_state_1,json=updateState1,proto3" json:"update_state_1,omitempty"`
+	UpdateState2 *UpdateStateMessage `protobuf:"bytes,2,opt,name=update_state_2,json=updateState2,proto3" json:"update_state_2,omitempty"`
+}
+
+func (m *Misbehaviour) Reset()         { *m = Misbehaviour{} }
+func (m *Misbehaviour) String() string { return proto.CompactTextString(m) }
+func (*Misbehaviour) ProtoMessage()    {}
+func (*Misbehaviour) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b11ad8927b76a597, []int{3}
+}
+func (m *Misbehaviour) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Misbehaviour) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Misbehaviour.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Misbehaviour) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Misbehaviour.Merge(m, src)
+}
+func (m *Misbehaviour) XXX_Size() int {
+	return m.Size()
+}
+func (m *Misbehaviour) XXX_DiscardUnknown() {
+	xxx_messageInfo_Misbehaviour.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Misbehaviour proto.InternalMessageInfo
+
 type Timestamp struct {
 	// Represents seconds of UTC time since Unix epoch
 	// 1970-01-01T00:00:00Z. Must be from 0001-01-01T00:00:00Z to
@@ -164,7 +204,7 @@ func (m *Timestamp) Reset()         { *m = Timestamp{} }
 func (m *Timestamp) String() string { return proto.CompactTextString(m) }
 func (*Timestamp) ProtoMessage()    {}
 func (*Timestamp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b11ad8927b76a597, []int{3}
+	return fileDescriptor_b11ad8927b76a597, []int{4}
 }
 func (m *Timestamp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -197,6 +237,7 @@ func init() {
 	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.tendermintzk.v1.ClientState")
 	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.tendermintzk.v1.ConsensusState")
 	proto.RegisterType((*UpdateStateMessage)(nil), "ibc.lightclients.tendermintzk.v1.UpdateStateMessage")
+	proto.RegisterType((*Misbehaviour)(nil), "ibc.lightclients.tendermintzk.v1.Misbehaviour")
 	proto.RegisterType((*Timestamp)(nil), "ibc.lightclients.tendermintzk.v1.Timestamp")
 }
 
@@ -205,43 +246,48 @@ func init() {
 }
 
 var fileDescriptor_b11ad8927b76a597 = []byte{
-	// 563 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x9b, 0xa4, 0x3f, 0xdb, 0x3f, 0xb0, 0x22, 0x64, 0x2a, 0x70, 0xad, 0x48, 0x88, 0x80,
-	0x54, 0xbb, 0x81, 0x2b, 0xe2, 0x90, 0x82, 0x54, 0x09, 0x10, 0x95, 0x09, 0x1c, 0x7a, 0xb1, 0xfc,
-	0x33, 0xb1, 0x57, 0x76, 0x76, 0x57, 0xbb, 0x9b, 0x48, 0xcd, 0x53, 0xf0, 0x24, 0x3c, 0x47, 0x8e,
-	0x3d, 0x72, 0x42, 0x90, 0x3c, 0x05, 0x37, 0xe4, 0x5d, 0x37, 0x75, 0xc2, 0xcd, 0xf3, 0xfd, 0xcc,
-	0xcc, 0x37, 0xd6, 0xa2, 0xb7, 0x38, 0x8a, 0xbd, 0x02, 0xa7, 0x99, 0x8c, 0x0b, 0x0c, 0x44, 0x0a,
-	0x4f, 0x02, 0x49, 0x80, 0x8f, 0x31, 0x91, 0xb3, 0xdc, 0x9b, 0xf6, 0xbd, 0xe1, 0xaa, 0xbe, 0xfe,
-	0xf0, 0xb1, 0x14, 0x5e, 0x28, 0xa1, 0xcb, 0x38, 0x95, 0xd4, 0x74, 0x70, 0x14, 0xbb, 0x75, 0xbf,
-	0x5b, 0xf7, 0xbb, 0xd3, 0xfe, 0x49, 0x27, 0xa5, 0x29, 0x55, 0x62, 0xaf, 0xfc, 0xd2, 0xbe, 0x93,
-	0xd3, 0x72, 0x6e, 0x4c, 0x39, 0x78, 0xda, 0x57, 0x4e, 0x8a, 0x6b, 0x8d, 0xbb, 0x7f, 0x0d, 0xb4,
-	0xaf, 0x27, 0x7d, 0x91, 0xa1, 0x04, 0xf3, 0x1c, 0x75, 0x84, 0x04, 0x16, 0x4c, 0x81, 0xe3, 0x11,
-	0x06, 0x1e, 0x24, 0x38, 0x05, 0x21, 0x2d, 0xc3, 0x31, 0x7a, 0x07, 0xbe, 0x59, 0x72, 0xdf, 0x2a,
-	0xea, 0x9d, 0x62, 0x94, 0x23, 0xc7, 0xff, 0x3b, 0xb6, 0x2a, 0x47, 0x8e, 0x37, 0x1d, 0xcf, 0xd1,
-	0xb1, 0xe4, 0x13, 0x21, 0x31, 0x49, 0x03, 0x06, 0x1c, 0xd3, 0xc4, 0x6a, 0x3a, 0x46, 0xaf, 0xe5,
-	0x1f, 0xdd, 0xc1, 0x57, 0x0a, 0x35, 0x1f, 0xa1, 0xed, 0x11, 0xa7, 0x33, 0x20, 0x56, 0xcb, 0x31,
-	0x7a, 0xbb, 0x7e, 0x55, 0x99, 0xef, 0xd1, 0x61, 0x11, 0x4a, 0x10, 0x32, 0xc8, 0xa0, 0xbc, 0x89,
-	0xd5, 0x76, 0x8c, 0xde, 0xfe, 0xab, 0x13, 0xb7, 0xbc, 0x52, 0x99, 0xd6, 0xad, 0x32, 0x4e, 0xfb,
-	0xee, 0xa5, 0x52, 0x0c, 0x5a, 0xf3, 0x5f, 0xa7, 0x0d, 0xff, 0x40, 0xdb, 0x34, 0xd6, 0xcd, 0xd0,
-	0xd1, 0x05, 0x25, 0x02, 0x88, 0x98, 0x08, 0x9d, 0xfe, 0x29, 0x42, 0x51, 0x41, 0xe3, 0x3c, 0xc8,
-	0x42, 0x91, 0x55, 0x99, 0xf7, 0x14, 0x72, 0x19, 0x8a, 0xcc, 0x7c, 0x8c, 0x76, 0x43, 0xc6, 0x34,
-	0xa9, 0xe3, 0xed, 0x84, 0x8c, 0x29, 0xea, 0x09, 0xda, 0x93, 0x78, 0x0c, 0x42, 0x86, 0x63, 0x56,
-	0xa5, 0xb9, 0x07, 0xba, 0x3f, 0xb6, 0x90, 0xf9, 0x95, 0x25, 0xa1, 0x04, 0x35, 0xe7, 0x13, 0x08,
-	0x11, 0xa6, 0x60, 0x3e, 0x43, 0x3a, 0x31, 0x24, 0x77, 0x41, 0x0c, 0xe5, 0x3c, 0xac, 0x50, 0xbd,
-	0xa7, 0xf9, 0x02, 0x3d, 0x98, 0x90, 0x0d, 0xe1, 0x96, 0x12, 0x1e, 0xaf, 0xf0, 0x4a, 0x7a, 0x8e,
-	0x3a, 0xf7, 0xd2, 0x5a, 0x94, 0xa6, 0xfe, 0x19, 0x2b, 0x6e, 0xb0, 0xca, 0xb4, 0xb6, 0x78, 0x6b,
-	0x63, 0xf1, 0xb5, 0xc4, 0xed, 0xf5, 0xc4, 0x2f, 0xd1, 0x43, 0x81, 0xc7, 0xac, 0x80, 0x40, 0x72,
-	0x80, 0x80, 0x71, 0x4a, 0x47, 0xd6, 0xb6, 0xd3, 0xec, 0x1d, 0xf8, 0xc7, 0x9a, 0x18, 0x72, 0x80,
-	0xab, 0x12, 0x36, 0x3b, 0xa8, 0x8d, 0x09, 0x9b, 0x48, 0x6b, 0x47, 0xf1, 0xba, 0x28, 0x9b, 0xcf,
-	0xf2, 0xca, 0xb8, 0xab, 0x9b, 0xcf, 0x72, 0x65, 0xe8, 0xbe, 0x41, 0x7b, 0xc3, 0xfa, 0x12, 0x02,
-	0x62, 0x4a, 0x12, 0x11, 0xa8, 0x03, 0x35, 0xfd, 0x9d, 0xaa, 0x2e, 0x1b, 0x93, 0x90, 0x50, 0xa1,
-	0xee, 0xd1, 0xf6, 0x75, 0x31, 0xf8, 0x3c, 0xff, 0x63, 0x37, 0xe6, 0x0b, 0xdb, 0xb8, 0x5d, 0xd8,
-	0xc6, 0xef, 0x85, 0x6d, 0x7c, 0x5f, 0xda, 0x8d, 0xdb, 0xa5, 0xdd, 0xf8, 0xb9, 0xb4, 0x1b, 0xd7,
-	0xfd, 0x14, 0xcb, 0x6c, 0x12, 0xb9, 0x31, 0x1d, 0x7b, 0x38, 0x8a, 0xcf, 0x04, 0x2d, 0x70, 0x82,
-	0xe5, 0x4d, 0xed, 0x49, 0x9e, 0xcd, 0xf2, 0xb3, 0x22, 0xf6, 0x52, 0xea, 0x71, 0x28, 0xc2, 0x9b,
-	0x68, 0x5b, 0x3d, 0x96, 0xd7, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x09, 0x40, 0x26, 0x33, 0xc7,
-	0x03, 0x00, 0x00,
+	// 644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcb, 0x6e, 0xd3, 0x4a,
+	0x18, 0x8e, 0x73, 0xe9, 0x65, 0x92, 0x5e, 0x8e, 0x15, 0x1d, 0xe5, 0x54, 0x07, 0x27, 0x8a, 0x84,
+	0x08, 0x48, 0xb5, 0x9b, 0xc0, 0x12, 0xb1, 0x48, 0x41, 0xaa, 0x04, 0x95, 0x2a, 0xb7, 0xb0, 0xe8,
+	0xc6, 0x1a, 0xdb, 0x7f, 0xed, 0x91, 0x9d, 0x19, 0xcb, 0x33, 0x8e, 0x44, 0x9e, 0x82, 0x35, 0x0f,
+	0xc1, 0x73, 0x74, 0xd9, 0x25, 0xab, 0x0a, 0xd2, 0x1d, 0x4f, 0x81, 0x66, 0xc6, 0x6d, 0x9d, 0x76,
+	0xc1, 0x86, 0x5d, 0xfe, 0xef, 0x32, 0xff, 0x7c, 0x5f, 0xe4, 0x41, 0x6f, 0x88, 0x1f, 0x38, 0x29,
+	0x89, 0x62, 0x11, 0xa4, 0x04, 0xa8, 0xe0, 0x8e, 0x00, 0x1a, 0x42, 0x3e, 0x23, 0x54, 0x2c, 0x12,
+	0x67, 0x3e, 0x76, 0xce, 0xee, 0xe6, 0xf3, 0xf7, 0x1f, 0xa4, 0xf0, 0x50, 0x09, 0xed, 0x2c, 0x67,
+	0x82, 0x99, 0x03, 0xe2, 0x07, 0x76, 0xd5, 0x6f, 0x57, 0xfd, 0xf6, 0x7c, 0xbc, 0xd7, 0x8d, 0x58,
+	0xc4, 0x94, 0xd8, 0x91, 0xbf, 0xb4, 0x6f, 0xaf, 0x2f, 0xf7, 0x06, 0x2c, 0x07, 0x47, 0xfb, 0xe4,
+	0xa6, 0xa0, 0x72, 0xf0, 0xf0, 0x6b, 0x1d, 0xb5, 0xf5, 0xa6, 0x53, 0x81, 0x05, 0x98, 0x07, 0xa8,
+	0xcb, 0x05, 0x64, 0xde, 0x1c, 0x72, 0x72, 0x41, 0x20, 0xf7, 0x42, 0x12, 0x01, 0x17, 0x3d, 0x63,
+	0x60, 0x8c, 0x3a, 0xae, 0x29, 0xb9, 0x4f, 0x25, 0xf5, 0x56, 0x31, 0xca, 0x91, 0x90, 0xc7, 0x8e,
+	0x7a, 0xe9, 0x48, 0xc8, 0x43, 0xc7, 0x33, 0xb4, 0x23, 0xf2, 0x82, 0x0b, 0x42, 0x23, 0x2f, 0x83,
+	0x9c, 0xb0, 0xb0, 0xd7, 0x18, 0x18, 0xa3, 0xa6, 0xbb, 0x7d, 0x0b, 0x9f, 0x28, 0xd4, 0xfc, 0x17,
+	0xad, 0x5d, 0xe4, 0x6c, 0x01, 0xb4, 0xd7, 0x1c, 0x18, 0xa3, 0x0d, 0xb7, 0x9c, 0xcc, 0x77, 0x68,
+	0x2b, 0xc5, 0x02, 0xb8, 0xf0, 0x62, 0x90, 0x9d, 0xf4, 0x5a, 0x03, 0x63, 0xd4, 0x9e, 0xec, 0xd9,
+	0xb2, 0x25, 0x99, 0xd6, 0x2e, 0x33, 0xce, 0xc7, 0xf6, 0x91, 0x52, 0x4c, 0x9b, 0x97, 0xd7, 0xfd,
+	0x9a, 0xdb, 0xd1, 0x36, 0x8d, 0x99, 0x7d, 0xd4, 0xce, 0x72, 0xc6, 0x2e, 0x3c, 0x9e, 0x41, 0xc0,
+	0x7b, 0x6b, 0x83, 0xc6, 0x68, 0xcb, 0x45, 0x0a, 0x3a, 0x95, 0xc8, 0x30, 0x46, 0xdb, 0x87, 0x8c,
+	0x72, 0xa0, 0xbc, 0xe0, 0xba, 0x9e, 0x27, 0x08, 0xf9, 0x29, 0x0b, 0x12, 0x2f, 0xc6, 0x3c, 0x2e,
+	0x4b, 0xd9, 0x54, 0xc8, 0x11, 0xe6, 0xb1, 0xf9, 0x1f, 0xda, 0xc0, 0x59, 0xa6, 0x49, 0x9d, 0x7f,
+	0x1d, 0x67, 0x99, 0xa2, 0xfe, 0x47, 0x9b, 0x82, 0xcc, 0x80, 0x0b, 0x3c, 0xcb, 0xca, 0xb8, 0xf7,
+	0xc0, 0xf0, 0x5b, 0x1d, 0x99, 0x1f, 0xb3, 0x10, 0x0b, 0x50, 0x7b, 0x8e, 0x81, 0x73, 0x1c, 0x81,
+	0xf9, 0x14, 0xe9, 0x4a, 0x20, 0xbc, 0x4d, 0x6a, 0x28, 0xe7, 0x56, 0x89, 0x96, 0x41, 0x9e, 0xa3,
+	0xdd, 0x82, 0x3e, 0x10, 0xd6, 0x95, 0x70, 0xa7, 0xa0, 0xab, 0xd2, 0x03, 0xd4, 0xbd, 0x97, 0x56,
+	0xa2, 0x34, 0xf4, 0xbf, 0x75, 0xc7, 0x4d, 0xef, 0x32, 0xad, 0x5c, 0xbc, 0xf9, 0xe0, 0xe2, 0x2b,
+	0x89, 0x5b, 0xab, 0x89, 0x5f, 0xa0, 0x7f, 0x38, 0x99, 0x65, 0x29, 0x78, 0x22, 0x07, 0xf0, 0x54,
+	0xaf, 0xaa, 0xe4, 0x8e, 0xbb, 0xa3, 0x89, 0xb3, 0x1c, 0xe0, 0x44, 0xc2, 0x66, 0x17, 0xb5, 0x08,
+	0xcd, 0x0a, 0xd1, 0x5b, 0x57, 0xbc, 0x1e, 0xe4, 0xe1, 0x8b, 0xa4, 0x34, 0x6e, 0xe8, 0xc3, 0x17,
+	0x89, 0x32, 0x0c, 0x7f, 0x19, 0xa8, 0x73, 0x4c, 0xb8, 0x0f, 0x31, 0x9e, 0x13, 0x56, 0xe4, 0x66,
+	0x8a, 0xb6, 0x0b, 0x55, 0xa0, 0xc7, 0x65, 0x83, 0xde, 0x58, 0x55, 0xd5, 0x9e, 0xbc, 0xb2, 0xff,
+	0xf4, 0xe9, 0xd8, 0x8f, 0x8b, 0x9f, 0xee, 0x2e, 0xaf, 0xfb, 0x9d, 0x0a, 0x3e, 0x76, 0x3b, 0x45,
+	0x65, 0x7a, 0xb4, 0x6d, 0xd2, 0xab, 0xff, 0xc5, 0x6d, 0x93, 0x95, 0x6d, 0x93, 0xe1, 0x6b, 0xb4,
+	0x79, 0x56, 0x6d, 0x9c, 0x43, 0xc0, 0x68, 0xc8, 0x3d, 0x15, 0xb1, 0xe1, 0xae, 0x97, 0xb3, 0x6c,
+	0x91, 0x62, 0xca, 0xb8, 0xba, 0x4c, 0xcb, 0xd5, 0xc3, 0xf4, 0xe4, 0xf2, 0xa7, 0x55, 0xbb, 0x5c,
+	0x5a, 0xc6, 0xd5, 0xd2, 0x32, 0x7e, 0x2c, 0x2d, 0xe3, 0xcb, 0x8d, 0x55, 0xbb, 0xba, 0xb1, 0x6a,
+	0xdf, 0x6f, 0xac, 0xda, 0xf9, 0x24, 0x22, 0x22, 0x2e, 0x7c, 0x3b, 0x60, 0x33, 0x27, 0xc4, 0x02,
+	0x07, 0x31, 0x26, 0x34, 0xc5, 0x7e, 0xe5, 0x81, 0xda, 0x5f, 0x24, 0xfb, 0xf2, 0x1d, 0x89, 0x98,
+	0x93, 0x43, 0x8a, 0x3f, 0xfb, 0x6b, 0xea, 0xed, 0x78, 0xf9, 0x7b, 0x00, 0x44, 0xd0, 0x6b, 0xbc,
+	0xd6, 0x04, 0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -264,6 +310,24 @@ func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProofSpecs) > 0 {
+		dAtA2 := make([]byte, len(m.ProofSpecs)*10)
+		var j1 int
+		for _, num := range m.ProofSpecs {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTendermintZKLightClient(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x32
+	}
 	{
 		size, err := m.LatestHeight.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -425,6 +489,53 @@ func (m *UpdateStateMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Misbehaviour) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Misbehaviour) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Misbehaviour) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdateState2 != nil {
+		{
+			size, err := m.UpdateState2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTendermintZKLightClient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.UpdateState1 != nil {
+		{
+			size, err := m.UpdateState1.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTendermintZKLightClient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Timestamp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -491,6 +602,13 @@ func (m *ClientState) Size() (n int) {
 	}
 	l = m.LatestHeight.Size()
 	n += 1 + l + sovTendermintZKLightClient(uint64(l))
+	if len(m.ProofSpecs) > 0 {
+		l = 0
+		for _, e := range m.ProofSpecs {
+			l += sovTendermintZKLightClient(uint64(e))
+		}
+		n += 1 + sovTendermintZKLightClient(uint64(l)) + l
+	}
 	return n
 }
 
@@ -556,6 +674,23 @@ func (m *UpdateStateMessage) Size() (n int) {
 	return n
 }
 
+func (m *Misbehaviour) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateState1 != nil {
+		l = m.UpdateState1.Size()
+		n += 1 + l + sovTendermintZKLightClient(uint64(l))
+	}
+	if m.UpdateState2 != nil {
+		l = m.UpdateState2.Size()
+		n += 1 + l + sovTendermintZKLightClient(uint64(l))
+	}
+	return n
+}
+
 func (m *Timestamp) Size() (n int) {
 	if m == nil {
 		return 0
@@ -746,6 +881,82 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTendermintZKLightClient
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ProofSpecs = append(m.ProofSpecs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTendermintZKLightClient
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTendermintZKLightClient
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTendermintZKLightClient
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ProofSpecs) == 0 {
+					m.ProofSpecs = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTendermintZKLightClient
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ProofSpecs = append(m.ProofSpecs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofSpecs", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTendermintZKLightClient(dAtA[iNdEx:])
@@ -1177,6 +1388,128 @@ func (m *UpdateStateMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Misbehaviour) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTendermintZKLightClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Misbehaviour: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Misbehaviour: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateState1", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermintZKLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermintZKLightClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermintZKLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateState1 == nil {
+				m.UpdateState1 = &UpdateStateMessage{}
+			}
+			if err := m.UpdateState1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateState2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermintZKLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermintZKLightClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermintZKLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateState2 == nil {
+				m.UpdateState2 = &UpdateStateMessage{}
+			}
+			if err := m.UpdateState2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTendermintZKLightClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTendermintZKLightClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Timestamp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
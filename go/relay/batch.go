@@ -0,0 +1,79 @@
+package relay
+
+import (
+	"bytes"
+	"fmt"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	"github.com/hyperledger-labs/yui-relayer/core"
+	"golang.org/x/sync/errgroup"
+)
+
+// DefaultProveStatesConcurrency is the maximum number of proof queries that `ProveStates` sends concurrently by default
+const DefaultProveStatesConcurrency = 8
+
+// StateProofRequest is a pair of an IBC path and its expected value
+type StateProofRequest struct {
+	Path  string
+	Value []byte
+}
+
+// ProveStates returns the existence proofs of multiple IBC states at the height of `ctx`
+// The header is fetched only once, and the proofs are queried concurrently up to `prove_states_concurrency` in the config.
+// The returned proofs are in the same order as `reqs`.
+func (pr *Prover) ProveStates(ctx core.QueryContext, reqs []StateProofRequest) ([][]byte, clienttypes.Height, error) {
+	return proveStates(ctx, pr.config.GetStoreLayout(), pr.config.GetProveStatesConcurrency(), reqs, pr.queryAppHash, pr.queryTendermintProof)
+}
+
+// proveStates is `ProveStates` with the queries to the chain
+func proveStates(
+	ctx core.QueryContext,
+	layout StoreLayout,
+	concurrency int,
+	reqs []StateProofRequest,
+	queryAppHash func(ctx core.QueryContext) ([32]byte, error),
+	queryProof func(ctx core.QueryContext, path string) ([]byte, *commitmenttypes.MerkleProof, clienttypes.Height, error),
+) ([][]byte, clienttypes.Height, error) {
+	if len(reqs) == 0 {
+		return nil, clienttypes.Height{}, fmt.Errorf("no state proof requests")
+	}
+	appHash, err := queryAppHash(ctx)
+	if err != nil {
+		return nil, clienttypes.Height{}, err
+	}
+
+	proofs := make([][]byte, len(reqs))
+	proofHeights := make([]clienttypes.Height, len(reqs))
+	eg, egCtx := errgroup.WithContext(ctx.Context())
+	eg.SetLimit(concurrency)
+	for i, req := range reqs {
+		i, req := i, req
+		eg.Go(func() error {
+			if err := egCtx.Err(); err != nil {
+				return err
+			}
+			v, merkleProof, proofHeight, err := queryProof(ctx, req.Path)
+			if err != nil {
+				return fmt.Errorf("failed to query the proof: path=%v %w", req.Path, err)
+			} else if !bytes.Equal(v, req.Value) {
+				return fmt.Errorf("value unmatch: path=%v %x != %x", req.Path, v, req.Value)
+			}
+			proof, err := verifyAndConvertToExistenceProof(layout, appHash, req.Path, req.Value, merkleProof)
+			if err != nil {
+				return fmt.Errorf("failed to convert the proof: path=%v %w", req.Path, err)
+			}
+			proofs[i], proofHeights[i] = proof, proofHeight
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, clienttypes.Height{}, err
+	}
+	for i := range proofHeights[1:] {
+		if !proofHeights[0].EQ(proofHeights[i+1]) {
+			return nil, clienttypes.Height{}, fmt.Errorf("proof height mismatch: %v != %v", proofHeights[0], proofHeights[i+1])
+		}
+	}
+	return proofs, proofHeights[0], nil
+}
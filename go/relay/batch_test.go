@@ -0,0 +1,167 @@
+package relay
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	"github.com/hyperledger-labs/yui-relayer/core"
+)
+
+// testStateQuerier serves the proofs of `testIBCStore` and records the queries
+type testStateQuerier struct {
+	root   [32]byte
+	proofs map[string]*commitmenttypes.MerkleProof
+
+	appHashQueries atomic.Int32
+	inFlight       atomic.Int32
+	maxInFlight    atomic.Int32
+
+	mu      sync.Mutex
+	heights map[string]clienttypes.Height
+	errs    map[string]error
+}
+
+func newTestStateQuerier(t *testing.T) *testStateQuerier {
+	q := &testStateQuerier{
+		proofs:  make(map[string]*commitmenttypes.MerkleProof),
+		heights: make(map[string]clienttypes.Height),
+		errs:    make(map[string]error),
+	}
+	for path := range testIBCStore {
+		q.root, q.proofs[path] = newTestMerkleProof(t, testIBCStore, path)
+	}
+	return q
+}
+
+func (q *testStateQuerier) queryAppHash(ctx core.QueryContext) ([32]byte, error) {
+	q.appHashQueries.Add(1)
+	return q.root, nil
+}
+
+func (q *testStateQuerier) queryProof(ctx core.QueryContext, path string) ([]byte, *commitmenttypes.MerkleProof, clienttypes.Height, error) {
+	n := q.inFlight.Add(1)
+	defer q.inFlight.Add(-1)
+	for {
+		m := q.maxInFlight.Load()
+		if n <= m || q.maxInFlight.CompareAndSwap(m, n) {
+			break
+		}
+	}
+	// the queries of the shorter paths finish later so that the results arrive out of order
+	time.Sleep(time.Duration(100-len(path)) * time.Millisecond / 10)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if err := q.errs[path]; err != nil {
+		return nil, nil, clienttypes.Height{}, err
+	}
+	height, ok := q.heights[path]
+	if !ok {
+		height = ctx.Height().(clienttypes.Height)
+	}
+	return testIBCStore[path], q.proofs[path], height, nil
+}
+
+func (q *testStateQuerier) prove(reqs []StateProofRequest, concurrency int) ([][]byte, clienttypes.Height, error) {
+	ctx := core.NewQueryContext(context.TODO(), clienttypes.NewHeight(REVISION_NUMBER, 10))
+	return proveStates(ctx, DefaultStoreLayout, concurrency, reqs, q.queryAppHash, q.queryProof)
+}
+
+func newTestStateProofRequests() []StateProofRequest {
+	var reqs []StateProofRequest
+	for path, value := range testIBCStore {
+		reqs = append(reqs, StateProofRequest{Path: path, Value: value})
+	}
+	sort.Slice(reqs, func(i, j int) bool { return reqs[i].Path < reqs[j].Path })
+	return reqs
+}
+
+func TestProveStates(t *testing.T) {
+	q := newTestStateQuerier(t)
+	reqs := newTestStateProofRequests()
+	proofs, height, err := q.prove(reqs, DefaultProveStatesConcurrency)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !height.EQ(clienttypes.NewHeight(REVISION_NUMBER, 10)) {
+		t.Fatalf("unexpected proof height: %v", height)
+	}
+	if n := q.appHashQueries.Load(); n != 1 {
+		t.Fatalf("the header must be fetched once: %d", n)
+	}
+	if len(proofs) != len(reqs) {
+		t.Fatalf("unexpected number of proofs: %d", len(proofs))
+	}
+	// the proofs are in the same order as the requests
+	for i, req := range reqs {
+		expected, err := verifyAndConvertToExistenceProof(DefaultStoreLayout, q.root, req.Path, req.Value, q.proofs[req.Path])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(proofs[i], expected) {
+			t.Errorf("%s: proof mismatch at %d", req.Path, i)
+		}
+		if err := VerifyEthABIExistenceProofs(DefaultStoreLayout.ProofSpecs(), q.root, []byte(DefaultIBCStoreKey), []byte(req.Path), req.Value, proofs[i]); err != nil {
+			t.Errorf("%s: %v", req.Path, err)
+		}
+	}
+}
+
+func TestProveStatesConcurrency(t *testing.T) {
+	reqs := newTestStateProofRequests()
+	for _, concurrency := range []int{1, 2} {
+		q := newTestStateQuerier(t)
+		if _, _, err := q.prove(reqs, concurrency); err != nil {
+			t.Fatal(err)
+		}
+		if n := q.maxInFlight.Load(); n > int32(concurrency) {
+			t.Errorf("%d: too many concurrent queries: %d", concurrency, n)
+		}
+	}
+	if c := (ProverConfig{}).GetProveStatesConcurrency(); c != DefaultProveStatesConcurrency {
+		t.Errorf("unexpected default concurrency: %d", c)
+	}
+	if c := (ProverConfig{ProveStatesConcurrency: 3}).GetProveStatesConcurrency(); c != 3 {
+		t.Errorf("unexpected concurrency: %d", c)
+	}
+}
+
+func TestProveStatesErrors(t *testing.T) {
+	errQuery := errors.New("query failed")
+	reqs := newTestStateProofRequests()
+	path := reqs[1].Path
+
+	cases := []struct {
+		name   string
+		reqs   []StateProofRequest
+		modify func(q *testStateQuerier)
+		err    string
+		is     error
+	}{
+		{"no requests", nil, func(q *testStateQuerier) {}, "no state proof requests", nil},
+		{"query error", reqs, func(q *testStateQuerier) { q.errs[path] = errQuery }, fmt.Sprintf("failed to query the proof: path=%v", path), errQuery},
+		{"value mismatch", append([]StateProofRequest{{Path: path, Value: []byte("other")}}, reqs...), func(q *testStateQuerier) {}, "value unmatch", nil},
+		{"invalid proof", reqs, func(q *testStateQuerier) { q.proofs[path] = q.proofs[reqs[0].Path] }, fmt.Sprintf("failed to convert the proof: path=%v", path), nil},
+		{"height mismatch", reqs, func(q *testStateQuerier) { q.heights[path] = clienttypes.NewHeight(REVISION_NUMBER, 11) }, "proof height mismatch", nil},
+	}
+	for _, c := range cases {
+		q := newTestStateQuerier(t)
+		c.modify(q)
+		proofs, _, err := q.prove(c.reqs, 2)
+		if err == nil || !strings.Contains(err.Error(), c.err) || (c.is != nil && !errors.Is(err, c.is)) {
+			t.Errorf("%s: unexpected result: %v", c.name, err)
+		} else if proofs != nil {
+			t.Errorf("%s: unexpected proofs on error", c.name)
+		}
+	}
+}
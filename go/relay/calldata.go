@@ -0,0 +1,67 @@
+package relay
+
+import (
+	"fmt"
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// ibcHandlerABI is the subset of the IBCHandler ABI of yui-ibc-solidity which is used to update clients
+var ibcHandlerABI, _ = abi.JSON(strings.NewReader(`[
+	{
+		"type": "function",
+		"name": "updateClient",
+		"inputs": [
+			{
+				"name": "msg_",
+				"type": "tuple",
+				"components": [
+					{"name": "clientId", "type": "string"},
+					{"name": "protoClientMessage", "type": "bytes"}
+				]
+			}
+		],
+		"outputs": [],
+		"stateMutability": "nonpayable"
+	}
+]`))
+
+// MarshalClientMessage returns the client message packed into Any, which `TendermintZKLightClient.routeUpdateClient` expects
+func MarshalClientMessage(msg exported.ClientMessage) ([]byte, error) {
+	anyMsg, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+	return anyMsg.Marshal()
+}
+
+// BuildUpdateClientCalldata returns the calldata of `IBCHandler.updateClient` which updates `clientID` with `msg`
+func BuildUpdateClientCalldata(clientID string, msg *UpdateStateMessage) ([]byte, error) {
+	protoClientMessage, err := MarshalClientMessage(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the client message: %w", err)
+	}
+	return ibcHandlerABI.Pack("updateClient", struct {
+		ClientId           string `json:"clientId"`
+		ProtoClientMessage []byte `json:"protoClientMessage"`
+	}{
+		ClientId:           clientID,
+		ProtoClientMessage: protoClientMessage,
+	})
+}
+
+// CalldataGas returns the intrinsic gas cost of `data` (EIP-2028)
+func CalldataGas(data []byte) uint64 {
+	var gas uint64
+	for _, b := range data {
+		if b == 0 {
+			gas += 4
+		} else {
+			gas += 16
+		}
+	}
+	return gas
+}
@@ -0,0 +1,49 @@
+package relay
+
+import (
+	"bytes"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+func TestBuildUpdateClientCalldata(t *testing.T) {
+	msg := newTestUpdateStateMessage(1, 2, 1)
+	calldata, err := BuildUpdateClientCalldata("tendermint-zk-0", msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	method := ibcHandlerABI.Methods["updateClient"]
+	if !bytes.Equal(calldata[:4], method.ID) {
+		t.Fatalf("unexpected selector: %x", calldata[:4])
+	}
+	args, err := method.Inputs.Unpack(calldata[4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := args[0].(struct {
+		ClientId           string `json:"clientId"`
+		ProtoClientMessage []byte `json:"protoClientMessage"`
+	})
+	if m.ClientId != "tendermint-zk-0" {
+		t.Fatalf("unexpected client id: %v", m.ClientId)
+	}
+	var anyMsg codectypes.Any
+	if err := anyMsg.Unmarshal(m.ProtoClientMessage); err != nil {
+		t.Fatal(err)
+	}
+	if anyMsg.TypeUrl != "/ibc.lightclients.tendermintzk.v1.UpdateStateMessage" {
+		t.Fatalf("unexpected type url: %v", anyMsg.TypeUrl)
+	}
+	var decoded UpdateStateMessage
+	if err := decoded.Unmarshal(anyMsg.Value); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(&decoded, msg) {
+		t.Fatalf("unexpected message: %v", decoded)
+	}
+	if gas := CalldataGas([]byte{0, 1, 0, 2}); gas != 40 {
+		t.Fatalf("unexpected calldata gas: %v", gas)
+	}
+}
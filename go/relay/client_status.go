@@ -0,0 +1,85 @@
+package relay
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/hyperledger-labs/yui-relayer/core"
+)
+
+// ClientStatus is the status of a tendermint-zk client at a point in time
+type ClientStatus struct {
+	Status       ibcexported.Status
+	LatestHeight clienttypes.Height
+	// Expiry is the time when the trusting period of the consensus state at the latest height passes
+	Expiry time.Time
+	// Remaining is the rest of the trusting period, which is zero if the client is expired
+	Remaining time.Duration
+}
+
+// NewClientStatus returns the status of the client at `now` in the same way as `ClientState.Status`.
+// `consState` is the consensus state at the latest height of the client, which is nil if not found.
+func NewClientStatus(cs *ClientState, consState *ConsensusState, now time.Time) ClientStatus {
+	status := ClientStatus{Status: ibcexported.Active, LatestHeight: cs.LatestHeight}
+	if consState != nil {
+		status.Expiry = time.Unix(0, int64(consState.Timestamp)).Add(time.Duration(cs.TrustingPeriod))
+		if remaining := status.Expiry.Sub(now); remaining > 0 {
+			status.Remaining = remaining
+		}
+	}
+	switch {
+	case cs.Frozen:
+		status.Status = ibcexported.Frozen
+	case consState == nil || cs.isExpired(consState, now):
+		status.Status = ibcexported.Expired
+	}
+	return status
+}
+
+// QueryCounterpartyClientState returns the client state of the tendermint-zk client of this chain on the counterparty chain at its latest height
+func (pr *Prover) QueryCounterpartyClientState(counterparty core.ChainInfoICS02Querier) (*ClientState, core.QueryContext, error) {
+	cph, err := counterparty.LatestHeight()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get the latest height of the counterparty chain: %w", err)
+	}
+	ctx := core.NewQueryContext(context.TODO(), cph)
+	res, err := counterparty.QueryClientState(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query the client state on the counterparty chain: %w", err)
+	}
+	var cs ibcexported.ClientState
+	if err := pr.chain.Codec().UnpackAny(res.ClientState, &cs); err != nil {
+		return nil, nil, fmt.Errorf("failed to unpack Any into tendermint-zk client state: %w", err)
+	}
+	clientState, ok := cs.(*ClientState)
+	if !ok {
+		return nil, nil, fmt.Errorf("unexpected client state type: %T", cs)
+	}
+	return clientState, ctx, nil
+}
+
+// QueryCounterpartyConsensusState returns the consensus state at `height` of the tendermint-zk client of this chain on the counterparty chain
+func (pr *Prover) QueryCounterpartyConsensusState(counterparty core.ICS02Querier, ctx core.QueryContext, height ibcexported.Height) (*ConsensusState, error) {
+	res, err := counterparty.QueryClientConsensusState(ctx, height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the consensus state on the counterparty chain: height=%v %w", height, err)
+	}
+	var cons ibcexported.ConsensusState
+	if err := pr.chain.Codec().UnpackAny(res.ConsensusState, &cons); err != nil {
+		return nil, fmt.Errorf("failed to unpack Any into tendermint-zk consensus state: %w", err)
+	}
+	consensusState, ok := cons.(*ConsensusState)
+	if !ok {
+		return nil, fmt.Errorf("unexpected consensus state type: %T", cons)
+	}
+	return consensusState, nil
+}
+
+// VerifyCounterpartyConsensusState checks that the consensus state stored in the counterparty client at `height` matches the header verified by the local light client
+func (pr *Prover) VerifyCounterpartyConsensusState(counterparty core.ICS02Querier, ctx core.QueryContext, height ibcexported.Height) error {
+	_, err := pr.verifyCounterpartyConsensusState(counterparty, ctx, height)
+	return err
+}
@@ -0,0 +1,37 @@
+package relay
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+func TestNewClientStatus(t *testing.T) {
+	timestamp := time.Unix(1700000000, 0)
+	trustingPeriod := 2 * time.Hour
+	cases := []struct {
+		name      string
+		frozen    bool
+		consState *ConsensusState
+		now       time.Time
+		status    exported.Status
+		remaining time.Duration
+	}{
+		{"active", false, &ConsensusState{Timestamp: uint64(timestamp.UnixNano())}, timestamp.Add(30 * time.Minute), exported.Active, 90 * time.Minute},
+		{"expired", false, &ConsensusState{Timestamp: uint64(timestamp.UnixNano())}, timestamp.Add(trustingPeriod), exported.Expired, 0},
+		{"frozen", true, &ConsensusState{Timestamp: uint64(timestamp.UnixNano())}, timestamp, exported.Frozen, trustingPeriod},
+		{"no consensus state", false, nil, timestamp, exported.Expired, 0},
+	}
+	for _, c := range cases {
+		cs := &ClientState{TrustingPeriod: uint64(trustingPeriod), Frozen: c.frozen, LatestHeight: clienttypes.NewHeight(REVISION_NUMBER, 10)}
+		status := NewClientStatus(cs, c.consState, c.now)
+		if status.Status != c.status || status.Remaining != c.remaining {
+			t.Errorf("%s: unexpected status: %v remaining=%v", c.name, status.Status, status.Remaining)
+		}
+		if c.consState != nil && !status.Expiry.Equal(timestamp.Add(trustingPeriod)) {
+			t.Errorf("%s: unexpected expiry: %v", c.name, status.Expiry)
+		}
+	}
+}
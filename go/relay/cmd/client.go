@@ -0,0 +1,243 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay"
+	"github.com/hyperledger-labs/yui-relayer/config"
+	"github.com/hyperledger-labs/yui-relayer/core"
+	"github.com/spf13/cobra"
+)
+
+func clientCmd(ctx *config.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "client",
+		Short: "manage the tendermint-zk clients of each chain on the counterparty chain of a path",
+	}
+
+	cmd.AddCommand(createClientCmd(ctx))
+	cmd.AddCommand(showClientCmd(ctx))
+	cmd.AddCommand(clientStatusCmd(ctx))
+	cmd.AddCommand(consensusStatesCmd(ctx))
+
+	return cmd
+}
+
+func createClientCmd(ctx *config.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [path-name] [chain-id]",
+		Short: "Create a tendermint-zk client of the chain on the counterparty chain of the path",
+		Long: `Create a tendermint-zk client of the chain on the counterparty chain of the path by:
+	1. building the client state and the consensus state at --height (default: the latest height) with the local light client
+	2. submitting MsgCreateClient to the counterparty chain
+	3. saving the client identifier to the path`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prover, counterparty, err := getPathChains(ctx, args[0], args[1])
+			if err != nil {
+				return err
+			}
+			signer, err := counterparty.GetAddress()
+			if err != nil {
+				return err
+			}
+
+			var height ibcexported.Height
+			if h, err := cmd.Flags().GetUint64(flags.FlagHeight); err != nil {
+				return err
+			} else if h != 0 {
+				height = clienttypes.NewHeight(relay.REVISION_NUMBER, h)
+			}
+			cs, cons, err := prover.CreateInitialLightClientState(height)
+			if err != nil {
+				return err
+			}
+			clientState, ok := cs.(*relay.ClientState)
+			if !ok {
+				return fmt.Errorf("unexpected client state type: %T", cs)
+			}
+			consensusState, ok := cons.(*relay.ConsensusState)
+			if !ok {
+				return fmt.Errorf("unexpected consensus state type: %T", cons)
+			}
+			printClientState(clientState)
+			printConsensusState(clientState.LatestHeight, consensusState)
+
+			msg, err := clienttypes.NewMsgCreateClient(clientState, consensusState, signer.String())
+			if err != nil {
+				return err
+			}
+			msgIDs, err := counterparty.SendMsgs([]sdk.Msg{msg})
+			if err != nil {
+				return fmt.Errorf("failed to submit MsgCreateClient: %w", err)
+			}
+			if err := core.SyncChainConfigFromEvents(args[0], msgIDs, counterparty); err != nil {
+				return err
+			}
+			fmt.Printf("created client on %s\n", counterparty.ChainID())
+			return nil
+		},
+	}
+	cmd.Flags().Uint64(flags.FlagHeight, 0, "height of the initial consensus state (default: the latest height)")
+	return cmd
+}
+
+func showClientCmd(ctx *config.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show [path-name] [chain-id]",
+		Short: "Show the tendermint-zk client of the chain on the counterparty chain of the path",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prover, counterparty, err := getPathChains(ctx, args[0], args[1])
+			if err != nil {
+				return err
+			}
+			cs, qctx, err := prover.QueryCounterpartyClientState(counterparty)
+			if err != nil {
+				return err
+			}
+			cons, err := prover.QueryCounterpartyConsensusState(counterparty, qctx, cs.LatestHeight)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("client_id: %s\n", counterparty.Path().ClientID)
+			printClientState(cs)
+			printConsensusState(cs.LatestHeight, cons)
+			return nil
+		},
+	}
+	return cmd
+}
+
+func clientStatusCmd(ctx *config.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status [path-name] [chain-id]",
+		Short: "Show the status and the remaining trusting period of the tendermint-zk client of the chain on the counterparty chain of the path",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prover, counterparty, err := getPathChains(ctx, args[0], args[1])
+			if err != nil {
+				return err
+			}
+			cs, qctx, err := prover.QueryCounterpartyClientState(counterparty)
+			if err != nil {
+				return err
+			}
+			cons, err := prover.QueryCounterpartyConsensusState(counterparty, qctx, cs.LatestHeight)
+			if err != nil {
+				return err
+			}
+			// the counterparty checks the trusting period with its block time
+			now, err := counterparty.Timestamp(qctx.Height())
+			if err != nil {
+				return fmt.Errorf("failed to get the timestamp of the counterparty chain: %w", err)
+			}
+			status := relay.NewClientStatus(cs, cons, now)
+
+			fmt.Printf("client_id: %s\n", counterparty.Path().ClientID)
+			fmt.Printf("status: %s\n", status.Status)
+			fmt.Printf("latest_height: %s\n", status.LatestHeight)
+			fmt.Printf("expiry: %s\n", status.Expiry.UTC().Format(time.RFC3339))
+			fmt.Printf("remaining_trusting_period: %s\n", status.Remaining.Round(time.Second))
+			if status.Status != ibcexported.Active {
+				return nil
+			}
+			refresh, err := prover.CheckRefreshRequired(counterparty)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("refresh_required: %t\n", refresh)
+			if err := prover.VerifyCounterpartyConsensusState(counterparty, qctx, cs.LatestHeight); err != nil {
+				fmt.Printf("local_light_client: %v\n", err)
+			} else {
+				fmt.Println("local_light_client: consistent")
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
+func consensusStatesCmd(ctx *config.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consensus-states [path-name] [chain-id] [height...]",
+		Short: "Show the consensus states of the tendermint-zk client of the chain on the counterparty chain of the path",
+		Long: `Show the consensus states at the given heights of the tendermint-zk client of the chain on the counterparty chain of the path.
+	The consensus state at the latest height of the client is shown if no height is given.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prover, counterparty, err := getPathChains(ctx, args[0], args[1])
+			if err != nil {
+				return err
+			}
+			cs, qctx, err := prover.QueryCounterpartyClientState(counterparty)
+			if err != nil {
+				return err
+			}
+			heights := []clienttypes.Height{cs.LatestHeight}
+			if len(args) > 2 {
+				heights = nil
+				for _, arg := range args[2:] {
+					h, err := strconv.ParseUint(arg, 10, 64)
+					if err != nil {
+						return fmt.Errorf("invalid height: %v", arg)
+					}
+					heights = append(heights, clienttypes.NewHeight(cs.LatestHeight.RevisionNumber, h))
+				}
+			}
+			for _, height := range heights {
+				cons, err := prover.QueryCounterpartyConsensusState(counterparty, qctx, height)
+				if err != nil {
+					return err
+				}
+				printConsensusState(height, cons)
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
+// getPathChains returns the prover of `chainID` and the counterparty chain in the path
+func getPathChains(ctx *config.Context, pathName string, chainID string) (*relay.Prover, *core.ProvableChain, error) {
+	chains, src, dst, err := ctx.Config.ChainsFromPath(pathName)
+	if err != nil {
+		return nil, nil, err
+	}
+	var self, counterparty string
+	switch chainID {
+	case src:
+		self, counterparty = src, dst
+	case dst:
+		self, counterparty = dst, src
+	default:
+		return nil, nil, fmt.Errorf("chain %v is not in the path %v", chainID, pathName)
+	}
+	prover, ok := chains[self].Prover.(*relay.Prover)
+	if !ok {
+		return nil, nil, fmt.Errorf("the prover of chain %v is not tendermintzk: %T", self, chains[self].Prover)
+	}
+	return prover, chains[counterparty], nil
+}
+
+func printClientState(cs *relay.ClientState) {
+	fmt.Printf("step_verifier_digest: 0x%s\n", hex.EncodeToString(cs.StepVerifierDigest))
+	fmt.Printf("skip_verifier_digest: 0x%s\n", hex.EncodeToString(cs.SkipVerifierDigest))
+	fmt.Printf("trusting_period: %s\n", time.Duration(cs.TrustingPeriod))
+	fmt.Printf("latest_height: %s\n", cs.LatestHeight)
+	fmt.Printf("frozen: %t\n", cs.Frozen)
+}
+
+func printConsensusState(height clienttypes.Height, cons *relay.ConsensusState) {
+	fmt.Printf("consensus_state@%s:\n", height)
+	fmt.Printf("  block_hash: 0x%s\n", hex.EncodeToString(cons.BlockHash))
+	fmt.Printf("  app_hash: 0x%s\n", hex.EncodeToString(cons.AppHash))
+	fmt.Printf("  timestamp: %s\n", time.Unix(0, int64(cons.Timestamp)).UTC().Format(time.RFC3339Nano))
+}
@@ -14,6 +14,10 @@ func TendermintZKCmd(m codec.Codec, ctx *config.Context) *cobra.Command {
 
 	cmd.AddCommand(
 		lightCmd(ctx),
+		simulateCmd(ctx),
+		proofCmd(ctx),
+		proveCmd(ctx),
+		clientCmd(ctx),
 	)
 
 	return cmd
@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay"
+	"github.com/hyperledger-labs/yui-relayer/config"
+	"github.com/spf13/cobra"
+)
+
+func proofCmd(ctx *config.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proof",
+		Short: "manage the zk proofs stored by the relayer for each chain",
+	}
+
+	cmd.AddCommand(listProofCmd(ctx))
+	cmd.AddCommand(showProofCmd(ctx))
+	cmd.AddCommand(resubmitProofCmd(ctx))
+
+	return cmd
+}
+
+func listProofCmd(ctx *config.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list [chain-id]",
+		Short: "List the stored proofs",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := ctx.Config.GetChain(args[0])
+			if err != nil {
+				return err
+			}
+			prover, ok := c.Prover.(*relay.Prover)
+			if !ok {
+				return fmt.Errorf("the prover of chain %v is not tendermintzk: %T", args[0], c.Prover)
+			}
+
+			artifacts, err := prover.ProofStore().List()
+			if err != nil {
+				return err
+			}
+			for _, a := range artifacts {
+				fmt.Printf("trusted_height=%d target_height=%d prover_type=%s created_at=%s\n", a.TrustedHeight, a.TargetHeight, a.ProverType, a.CreatedAt.Format(time.RFC3339))
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
+func showProofCmd(ctx *config.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show [chain-id]",
+		Short: "Show the stored proof from --trusted to --target",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := ctx.Config.GetChain(args[0])
+			if err != nil {
+				return err
+			}
+			prover, ok := c.Prover.(*relay.Prover)
+			if !ok {
+				return fmt.Errorf("the prover of chain %v is not tendermintzk: %T", args[0], c.Prover)
+			}
+
+			trusted, err := cmd.Flags().GetUint64(flagTrusted)
+			if err != nil {
+				return err
+			}
+			target, err := cmd.Flags().GetUint64(flagTarget)
+			if err != nil {
+				return err
+			}
+			a, err := prover.ProofStore().Get(trusted, target)
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(a, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(bz))
+			return nil
+		},
+	}
+	cmd.Flags().Uint64(flagTrusted, 0, "trusted height")
+	cmd.Flags().Uint64(flagTarget, 0, "target height")
+	for _, f := range []string{flagTrusted, flagTarget} {
+		if err := cmd.MarkFlagRequired(f); err != nil {
+			panic(err)
+		}
+	}
+	return cmd
+}
+
+func resubmitProofCmd(ctx *config.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resubmit [path-name] [chain-id]",
+		Short: "Update the counterparty client with a stored proof of the chain",
+		Long: `Update the client of the chain on the counterparty chain of the path with a stored proof.
+	By default, the stored proof with the highest target height from the latest height of the client is used.
+	Use --trusted and --target to choose another proof.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prover, counterparty, err := getPathChains(ctx, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			trusted, err := cmd.Flags().GetUint64(flagTrusted)
+			if err != nil {
+				return err
+			}
+			target, err := cmd.Flags().GetUint64(flagTarget)
+			if err != nil {
+				return err
+			}
+			msg, err := prover.ResubmitProof(counterparty, trusted, target)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("updated client %s on %s: trusted_height=%d target_height=%d\n", counterparty.Path().ClientID, counterparty.ChainID(), msg.TrustedHeight, msg.UntrustedHeight)
+			return nil
+		},
+	}
+	cmd.Flags().Uint64(flagTrusted, 0, "trusted height (default: the latest height of the client)")
+	cmd.Flags().Uint64(flagTarget, 0, "target height (default: the highest stored target height)")
+	return cmd
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay"
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/hyperledger-labs/yui-relayer/config"
+	"github.com/spf13/cobra"
+)
+
+const flagCalldata = "calldata"
+
+func proveCmd(ctx *config.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prove [chain-id]",
+		Short: "Request a proof from the configured ZK prover and print it",
+		Long: `Request a proof of the update from --trusted to --target from the configured ZK prover and print:
+	1. the public inputs
+	2. the decoded proof
+	3. the UpdateStateMessage with the proof
+Use --calldata to write the calldata of IBCHandler.updateClient with the message to a file.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := ctx.Config.GetChain(args[0])
+			if err != nil {
+				return err
+			}
+			prover, ok := c.Prover.(*relay.Prover)
+			if !ok {
+				return fmt.Errorf("the prover of chain %v is not tendermintzk: %T", args[0], c.Prover)
+			}
+
+			trusted, err := cmd.Flags().GetUint64(flagTrusted)
+			if err != nil {
+				return err
+			}
+			target, err := cmd.Flags().GetUint64(flagTarget)
+			if err != nil {
+				return err
+			}
+			calldataPath, err := cmd.Flags().GetString(flagCalldata)
+			if err != nil {
+				return err
+			}
+			clientID, err := cmd.Flags().GetString(flagClientID)
+			if err != nil {
+				return err
+			}
+
+			zkProof, msg, err := prover.ProveUpdateState(trusted, target)
+			if err != nil {
+				return err
+			}
+
+			ps, err := zkp.Get(zkProof.Proof.ProverType())
+			if err != nil {
+				return err
+			}
+			fmt.Println("public inputs:")
+			for i := range zkProof.Input {
+				name := fmt.Sprintf("input[%d]", i)
+				if i < len(ps.PublicInputs) {
+					name = ps.PublicInputs[i]
+				}
+				in := big.Int(zkProof.Input[i])
+				fmt.Printf("  %s: 0x%064x\n", name, &in)
+			}
+			bz, err := json.MarshalIndent(zkProof.Proof, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Printf("proof (%s):\n%s\n", ps.Name, bz)
+			bz, err = json.MarshalIndent(msg, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Printf("update state message:\n%s\n", bz)
+
+			if calldataPath != "" {
+				calldata, err := relay.BuildUpdateClientCalldata(clientID, msg)
+				if err != nil {
+					return err
+				}
+				if err := os.WriteFile(calldataPath, []byte(hexutil.Encode(calldata)), 0o644); err != nil {
+					return err
+				}
+				fmt.Printf("wrote IBCHandler.updateClient calldata to %s: %d bytes, %d gas\n", calldataPath, len(calldata), relay.CalldataGas(calldata))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Uint64(flagTrusted, 0, "trusted height")
+	cmd.Flags().Uint64(flagTarget, 0, "target height")
+	cmd.Flags().String(flagCalldata, "", "path to write the hex-encoded calldata of IBCHandler.updateClient")
+	cmd.Flags().String(flagClientID, "tendermint-zk-0", "client identifier in the calldata")
+	for _, f := range []string{flagTrusted, flagTarget} {
+		if err := cmd.MarkFlagRequired(f); err != nil {
+			panic(err)
+		}
+	}
+	return cmd
+}
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"fmt"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay"
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay/evmsim"
+	"github.com/hyperledger-labs/yui-relayer/config"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagArtifact = "artifact"
+	flagTrusted  = "trusted"
+	flagTarget   = "target"
+	flagClientID = "client-id"
+)
+
+func simulateCmd(ctx *config.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-update [chain-id]",
+		Short: "Simulate updateClient on a local EVM and report the gas used",
+		Long: `Simulate updateClient on a local EVM by:
+	1. deploying the light client contract of the forge artifact (e.g. out/TendermintZKLightClientGroth16.sol/TendermintZKLightClientGroth16.json)
+	2. initializing the client with the consensus state at --trusted
+	3. updating the client to --target with a proof requested from the configured ZK prover`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := ctx.Config.GetChain(args[0])
+			if err != nil {
+				return err
+			}
+			prover := c.Prover.(*relay.Prover)
+
+			artifactPath, err := cmd.Flags().GetString(flagArtifact)
+			if err != nil {
+				return err
+			}
+			trusted, err := cmd.Flags().GetUint64(flagTrusted)
+			if err != nil {
+				return err
+			}
+			target, err := cmd.Flags().GetUint64(flagTarget)
+			if err != nil {
+				return err
+			}
+			clientID, err := cmd.Flags().GetString(flagClientID)
+			if err != nil {
+				return err
+			}
+
+			artifact, err := evmsim.LoadArtifact(artifactPath)
+			if err != nil {
+				return err
+			}
+			cs, cons, err := prover.CreateInitialLightClientState(clienttypes.NewHeight(relay.REVISION_NUMBER, trusted))
+			if err != nil {
+				return err
+			}
+			clientState, consensusState := cs.(*relay.ClientState), cons.(*relay.ConsensusState)
+			msg, err := prover.BuildUpdateStateMessage(trusted, target)
+			if err != nil {
+				return err
+			}
+
+			sim, err := evmsim.NewSimulator(artifact, clientState.StepVerifierDigest, clientState.SkipVerifierDigest, relay.REVISION_NUMBER)
+			if err != nil {
+				return err
+			}
+			defer sim.Close()
+
+			protoClientState, err := clientState.Marshal()
+			if err != nil {
+				return err
+			}
+			protoConsensusState, err := consensusState.Marshal()
+			if err != nil {
+				return err
+			}
+			res, err := sim.InitializeClient(clientID, protoClientState, protoConsensusState)
+			if err != nil {
+				return err
+			} else if res.Reverted {
+				return fmt.Errorf("failed to initialize the client: %v", res.RevertReason)
+			}
+
+			protoClientMessage, err := relay.MarshalClientMessage(msg)
+			if err != nil {
+				return err
+			}
+			res, err = sim.UpdateClient(clientID, protoClientMessage)
+			if err != nil {
+				return err
+			}
+			calldata, err := relay.BuildUpdateClientCalldata(clientID, msg)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("trusted height: %d\n", trusted)
+			fmt.Printf("target height: %d\n", target)
+			fmt.Printf("gas used: %d\n", res.GasUsed)
+			fmt.Printf("light client calldata gas: %d\n", res.CalldataGas)
+			fmt.Printf("IBCHandler.updateClient calldata: %d bytes, %d gas\n", len(calldata), relay.CalldataGas(calldata))
+			if res.Reverted {
+				fmt.Printf("reverted: %s\n", res.RevertReason)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String(flagArtifact, "", "path to the forge artifact of the light client contract")
+	cmd.Flags().Uint64(flagTrusted, 0, "trusted height")
+	cmd.Flags().Uint64(flagTarget, 0, "target height")
+	cmd.Flags().String(flagClientID, "tendermint-zk-0", "client identifier")
+	for _, f := range []string{flagArtifact, flagTrusted, flagTarget} {
+		if err := cmd.MarkFlagRequired(f); err != nil {
+			panic(err)
+		}
+	}
+	return cmd
+}
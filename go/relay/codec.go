@@ -19,6 +19,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*exported.ClientMessage)(nil),
 		&UpdateStateMessage{},
+		&Misbehaviour{},
 	)
 	registry.RegisterImplementations(
 		(*core.ProverConfig)(nil),
@@ -3,13 +3,23 @@ package relay
 import (
 	"encoding/hex"
 	"fmt"
+	"net/url"
 	"strings"
 	"time"
 
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	cmtmath "github.com/cometbft/cometbft/libs/math"
+	"github.com/cometbft/cometbft/light"
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp"
 	"github.com/hyperledger-labs/yui-relayer/chains/tendermint"
 	"github.com/hyperledger-labs/yui-relayer/core"
 )
 
+const (
+	VerificationModeSkipping   = "skipping"
+	VerificationModeSequential = "sequential"
+)
+
 var _ core.ProverConfig = (*ProverConfig)(nil)
 
 func (c ProverConfig) Build(chain core.Chain) (core.Prover, error) {
@@ -24,6 +34,15 @@ func (c ProverConfig) Validate() error {
 	if c.ProverType == "" {
 		return fmt.Errorf("prover type cannot be empty")
 	}
+	if _, err := zkp.Get(c.ProverType); err != nil {
+		return fmt.Errorf("invalid prover type: %w (supported: %v)", err, zkp.Names())
+	}
+	if ps, _ := zkp.Get(c.ProverType); c.VerifyingKeyPath != "" && ps.NewVerifier == nil {
+		return fmt.Errorf("verifying key cannot be configured for the prover type %v, which does not accept a verifying key", c.ProverType)
+	}
+	if c.ProverType == EmbeddedProverType && c.GnarkDataDir == "" {
+		return fmt.Errorf("gnark data directory must be configured for the prover type %v", EmbeddedProverType)
+	}
 	if _, err := hex.DecodeString(strings.TrimPrefix(c.StepVerifierDigest, "0x")); err != nil {
 		return fmt.Errorf("invalid step verifier digest: %w", err)
 	}
@@ -33,6 +52,58 @@ func (c ProverConfig) Validate() error {
 	if _, err := time.ParseDuration(c.TrustingPeriod); err != nil {
 		return fmt.Errorf("invalid trusting period: %w", err)
 	}
+	for _, addr := range c.WitnessAddrs {
+		if _, err := url.ParseRequestURI(addr); err != nil {
+			return fmt.Errorf("invalid witness address: %w", err)
+		}
+	}
+	switch c.VerificationMode {
+	case "", VerificationModeSkipping, VerificationModeSequential:
+	default:
+		return fmt.Errorf("invalid verification mode: %v", c.VerificationMode)
+	}
+	if c.TrustLevel != nil {
+		if err := light.ValidateTrustLevel(c.GetTrustLevel()); err != nil {
+			return fmt.Errorf("invalid trust level: %w", err)
+		}
+	}
+	if c.MaxClockDrift != "" {
+		if _, err := time.ParseDuration(c.MaxClockDrift); err != nil {
+			return fmt.Errorf("invalid max clock drift: %w", err)
+		}
+	}
+	if c.MaxBlockLag != "" {
+		if _, err := time.ParseDuration(c.MaxBlockLag); err != nil {
+			return fmt.Errorf("invalid max block lag: %w", err)
+		}
+	}
+	if c.LightLogLevel != "" {
+		if _, err := cmtlog.AllowLevel(c.LightLogLevel); err != nil {
+			return fmt.Errorf("invalid light log level: %w", err)
+		}
+	}
+	if len(c.ProofSpecs) != 0 {
+		// the contract verifies the existence proofs of the IBC store and the app hash tree
+		if len(c.ProofSpecs) != 2 {
+			return fmt.Errorf("the number of proof specs must be 2: actual=%v", len(c.ProofSpecs))
+		}
+		for _, name := range c.ProofSpecs {
+			if _, err := GetTreeSpec(name); err != nil {
+				return err
+			}
+		}
+	}
+	if r := c.RefreshThresholdRate; r != nil {
+		if r.Denominator == 0 {
+			return fmt.Errorf("refresh threshold rate denominator must not be zero")
+		}
+		if r.Numerator == 0 {
+			return fmt.Errorf("refresh threshold rate numerator must not be zero")
+		}
+		if r.Numerator > r.Denominator {
+			return fmt.Errorf("refresh threshold rate must be less than or equal to 1.0: actual=%v/%v", r.Numerator, r.Denominator)
+		}
+	}
 	return nil
 }
 
@@ -59,3 +130,65 @@ func (c ProverConfig) GetTrustingPeriod() time.Duration {
 	}
 	return d
 }
+
+// defaultRefreshThresholdRate is used when `refresh_threshold_rate` is not configured
+var defaultRefreshThresholdRate = Fraction{Numerator: 2, Denominator: 3}
+
+func (c ProverConfig) GetRefreshThresholdRate() Fraction {
+	if c.RefreshThresholdRate == nil {
+		return defaultRefreshThresholdRate
+	}
+	return *c.RefreshThresholdRate
+}
+
+// GetTrustLevel returns the trust level for skipping verification, which defaults to 1/3
+func (c ProverConfig) GetTrustLevel() cmtmath.Fraction {
+	if c.TrustLevel == nil {
+		return light.DefaultTrustLevel
+	}
+	return cmtmath.Fraction{Numerator: c.TrustLevel.Numerator, Denominator: c.TrustLevel.Denominator}
+}
+
+// GetProveStatesConcurrency returns the maximum number of proof queries that `ProveStates` sends concurrently, which defaults to `DefaultProveStatesConcurrency`
+func (c ProverConfig) GetProveStatesConcurrency() int {
+	if c.ProveStatesConcurrency == 0 {
+		return DefaultProveStatesConcurrency
+	}
+	return int(c.ProveStatesConcurrency)
+}
+
+// GetMisbehaviourLookback returns the maximum number of light blocks checked for a height trusted by the counterparty client when reporting misbehaviour, which defaults to `DefaultMisbehaviourLookback`
+func (c ProverConfig) GetMisbehaviourLookback() int {
+	if c.MisbehaviourLookback == 0 {
+		return DefaultMisbehaviourLookback
+	}
+	return int(c.MisbehaviourLookback)
+}
+
+// GetLightLogLevel returns the level of light client logs routed into the relayer logger, which defaults to "none"
+func (c ProverConfig) GetLightLogLevel() string {
+	if c.LightLogLevel == "" {
+		return "none"
+	}
+	return c.LightLogLevel
+}
+
+// GetStoreLayout returns the layout of the IBC store, which defaults to `DefaultStoreLayout`
+func (c ProverConfig) GetStoreLayout() StoreLayout {
+	layout := DefaultStoreLayout
+	if c.IbcStoreKey != "" {
+		layout.StoreKey = c.IbcStoreKey
+		layout.CommitmentPrefix = c.IbcStoreKey
+	}
+	if c.CommitmentPrefix != "" {
+		layout.CommitmentPrefix = c.CommitmentPrefix
+	}
+	for i, name := range c.ProofSpecs {
+		spec, err := GetTreeSpec(name)
+		if err != nil {
+			panic(err)
+		}
+		layout.Specs[i] = spec
+	}
+	return layout
+}
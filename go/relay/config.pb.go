@@ -24,11 +24,26 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type ProverConfig struct {
-	ZkProverAddr       string `protobuf:"bytes,1,opt,name=zk_prover_addr,json=zkProverAddr,proto3" json:"zk_prover_addr,omitempty"`
-	StepVerifierDigest string `protobuf:"bytes,2,opt,name=step_verifier_digest,json=stepVerifierDigest,proto3" json:"step_verifier_digest,omitempty"`
-	SkipVerifierDigest string `protobuf:"bytes,3,opt,name=skip_verifier_digest,json=skipVerifierDigest,proto3" json:"skip_verifier_digest,omitempty"`
-	ProverType         string `protobuf:"bytes,4,opt,name=prover_type,json=proverType,proto3" json:"prover_type,omitempty"`
-	TrustingPeriod     string `protobuf:"bytes,5,opt,name=trusting_period,json=trustingPeriod,proto3" json:"trusting_period,omitempty"`
+	ZkProverAddr           string    `protobuf:"bytes,1,opt,name=zk_prover_addr,json=zkProverAddr,proto3" json:"zk_prover_addr,omitempty"`
+	StepVerifierDigest     string    `protobuf:"bytes,2,opt,name=step_verifier_digest,json=stepVerifierDigest,proto3" json:"step_verifier_digest,omitempty"`
+	SkipVerifierDigest     string    `protobuf:"bytes,3,opt,name=skip_verifier_digest,json=skipVerifierDigest,proto3" json:"skip_verifier_digest,omitempty"`
+	ProverType             string    `protobuf:"bytes,4,opt,name=prover_type,json=proverType,proto3" json:"prover_type,omitempty"`
+	TrustingPeriod         string    `protobuf:"bytes,5,opt,name=trusting_period,json=trustingPeriod,proto3" json:"trusting_period,omitempty"`
+	RefreshThresholdRate   *Fraction `protobuf:"bytes,6,opt,name=refresh_threshold_rate,json=refreshThresholdRate,proto3" json:"refresh_threshold_rate,omitempty"`
+	WitnessAddrs           []string  `protobuf:"bytes,7,rep,name=witness_addrs,json=witnessAddrs,proto3" json:"witness_addrs,omitempty"`
+	ReportMisbehaviour     bool      `protobuf:"varint,8,opt,name=report_misbehaviour,json=reportMisbehaviour,proto3" json:"report_misbehaviour,omitempty"`
+	VerificationMode       string    `protobuf:"bytes,9,opt,name=verification_mode,json=verificationMode,proto3" json:"verification_mode,omitempty"`
+	TrustLevel             *Fraction `protobuf:"bytes,10,opt,name=trust_level,json=trustLevel,proto3" json:"trust_level,omitempty"`
+	MaxClockDrift          string    `protobuf:"bytes,11,opt,name=max_clock_drift,json=maxClockDrift,proto3" json:"max_clock_drift,omitempty"`
+	MaxBlockLag            string    `protobuf:"bytes,12,opt,name=max_block_lag,json=maxBlockLag,proto3" json:"max_block_lag,omitempty"`
+	LightLogLevel          string    `protobuf:"bytes,13,opt,name=light_log_level,json=lightLogLevel,proto3" json:"light_log_level,omitempty"`
+	IbcStoreKey            string    `protobuf:"bytes,14,opt,name=ibc_store_key,json=ibcStoreKey,proto3" json:"ibc_store_key,omitempty"`
+	CommitmentPrefix       string    `protobuf:"bytes,15,opt,name=commitment_prefix,json=commitmentPrefix,proto3" json:"commitment_prefix,omitempty"`
+	ProofSpecs             []string  `protobuf:"bytes,16,rep,name=proof_specs,json=proofSpecs,proto3" json:"proof_specs,omitempty"`
+	GnarkDataDir           string    `protobuf:"bytes,17,opt,name=gnark_data_dir,json=gnarkDataDir,proto3" json:"gnark_data_dir,omitempty"`
+	VerifyingKeyPath       string    `protobuf:"bytes,18,opt,name=verifying_key_path,json=verifyingKeyPath,proto3" json:"verifying_key_path,omitempty"`
+	ProveStatesConcurrency uint32    `protobuf:"varint,19,opt,name=prove_states_concurrency,json=proveStatesConcurrency,proto3" json:"prove_states_concurrency,omitempty"`
+	MisbehaviourLookback   uint32    `protobuf:"varint,20,opt,name=misbehaviour_lookback,json=misbehaviourLookback,proto3" json:"misbehaviour_lookback,omitempty"`
 }
 
 func (m *ProverConfig) Reset()         { *m = ProverConfig{} }
@@ -64,8 +79,47 @@ func (m *ProverConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProverConfig proto.InternalMessageInfo
 
+type Fraction struct {
+	Numerator   uint64 `protobuf:"varint,1,opt,name=numerator,proto3" json:"numerator,omitempty"`
+	Denominator uint64 `protobuf:"varint,2,opt,name=denominator,proto3" json:"denominator,omitempty"`
+}
+
+func (m *Fraction) Reset()         { *m = Fraction{} }
+func (m *Fraction) String() string { return proto.CompactTextString(m) }
+func (*Fraction) ProtoMessage()    {}
+func (*Fraction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baf01ad3109d9ad3, []int{1}
+}
+func (m *Fraction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Fraction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Fraction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Fraction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Fraction.Merge(m, src)
+}
+func (m *Fraction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Fraction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Fraction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Fraction proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ProverConfig)(nil), "relayer.provers.tendermintzk.config.ProverConfig")
+	proto.RegisterType((*Fraction)(nil), "relayer.provers.tendermintzk.config.Fraction")
 }
 
 func init() {
@@ -73,26 +127,51 @@ func init() {
 }
 
 var fileDescriptor_baf01ad3109d9ad3 = []byte{
-	// 298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0x4f, 0x4b, 0xc3, 0x30,
-	0x18, 0xc6, 0x5b, 0xff, 0x81, 0x71, 0x4c, 0x28, 0x3b, 0x14, 0x0f, 0x51, 0x54, 0xd0, 0xcb, 0x9a,
-	0x89, 0x9f, 0xc0, 0x3f, 0x77, 0x87, 0x88, 0x07, 0x2f, 0x65, 0x6b, 0xde, 0xc5, 0x97, 0x6e, 0x4d,
-	0x78, 0x9b, 0x0d, 0xda, 0x4f, 0xe1, 0xc7, 0xda, 0x71, 0x47, 0x8f, 0xda, 0x7d, 0x11, 0x59, 0x1a,
-	0x61, 0xe8, 0x29, 0xe1, 0x79, 0x7e, 0xbf, 0x10, 0x1e, 0x36, 0x20, 0x98, 0x8e, 0x2a, 0x20, 0x61,
-	0x48, 0x2f, 0x80, 0x4a, 0x61, 0xa1, 0x90, 0x40, 0x33, 0x2c, 0x6c, 0x9d, 0x8b, 0x4c, 0x17, 0x13,
-	0x54, 0xfe, 0x48, 0x0c, 0x69, 0xab, 0xa3, 0x0b, 0x6f, 0x24, 0xde, 0x48, 0xb6, 0x8d, 0xa4, 0x45,
-	0x4f, 0x7a, 0x4a, 0x2b, 0xed, 0x78, 0xb1, 0xb9, 0xb5, 0xea, 0x79, 0x13, 0xb2, 0xce, 0xd0, 0x59,
-	0x0f, 0x0e, 0x8b, 0x2e, 0x59, 0xb7, 0xce, 0xd3, 0xf6, 0xa1, 0x74, 0x24, 0x25, 0xc5, 0xe1, 0x59,
-	0x78, 0x7d, 0xf8, 0xdc, 0xa9, 0xf3, 0x96, 0xbb, 0x93, 0x92, 0xa2, 0x01, 0xeb, 0x95, 0x16, 0x4c,
-	0xba, 0x00, 0xc2, 0x09, 0x02, 0xa5, 0x12, 0x15, 0x94, 0x36, 0xde, 0x71, 0x6c, 0xb4, 0xe9, 0x5e,
-	0x7d, 0xf5, 0xe8, 0x1a, 0x67, 0xe4, 0xf8, 0xdf, 0xd8, 0xf5, 0x46, 0x8e, 0x7f, 0x8d, 0x53, 0x76,
-	0xe4, 0xbf, 0x61, 0x2b, 0x03, 0xf1, 0x9e, 0x03, 0x59, 0x1b, 0xbd, 0x54, 0x06, 0xa2, 0x2b, 0x76,
-	0x6c, 0x69, 0x5e, 0x5a, 0x2c, 0x54, 0x6a, 0x80, 0x50, 0xcb, 0x78, 0xdf, 0x41, 0xdd, 0xdf, 0x78,
-	0xe8, 0xd2, 0xfb, 0xa7, 0xe5, 0x37, 0x0f, 0x96, 0x0d, 0x0f, 0x57, 0x0d, 0x0f, 0xbf, 0x1a, 0x1e,
-	0x7e, 0xac, 0x79, 0xb0, 0x5a, 0xf3, 0xe0, 0x73, 0xcd, 0x83, 0xb7, 0x1b, 0x85, 0xf6, 0x7d, 0x3e,
-	0x4e, 0x32, 0x3d, 0x13, 0x38, 0xce, 0xfa, 0xa5, 0x9e, 0xa2, 0x44, 0x5b, 0x6d, 0xed, 0xde, 0xaf,
-	0xf3, 0xfe, 0x34, 0x13, 0x4a, 0x0b, 0xb7, 0xf4, 0xf8, 0xc0, 0x8d, 0x77, 0xfb, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x5d, 0x4d, 0x49, 0x1c, 0xab, 0x01, 0x00, 0x00,
+	// 700 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcb, 0x4e, 0x1b, 0x3b,
+	0x18, 0xce, 0x00, 0x87, 0x8b, 0x43, 0xb8, 0x98, 0x1c, 0x64, 0x1d, 0x1d, 0xa5, 0x51, 0xa8, 0xda,
+	0x48, 0x2d, 0x19, 0x04, 0x9b, 0x6e, 0x0b, 0x51, 0x17, 0x25, 0x54, 0x51, 0x40, 0x5d, 0x74, 0x63,
+	0x79, 0x3c, 0x7f, 0x66, 0xac, 0xb9, 0x78, 0xe4, 0x71, 0xd2, 0x4c, 0x9e, 0xa2, 0xef, 0xd4, 0x0d,
+	0x4b, 0x96, 0x5d, 0xb6, 0xf0, 0x22, 0x95, 0x3d, 0x83, 0x18, 0xb5, 0x9b, 0xaa, 0xab, 0x89, 0xbe,
+	0x9b, 0xed, 0xcf, 0x7f, 0x8c, 0x4e, 0x14, 0xc4, 0xac, 0x00, 0xe5, 0x66, 0x4a, 0xce, 0x41, 0xe5,
+	0xae, 0x86, 0xd4, 0x07, 0x95, 0x88, 0x54, 0x2f, 0x23, 0x97, 0xcb, 0x74, 0x2a, 0x82, 0xea, 0x33,
+	0xc8, 0x94, 0xd4, 0x12, 0x1f, 0x55, 0x8e, 0x41, 0xe5, 0x18, 0xd4, 0x1d, 0x83, 0x52, 0xfa, 0x5f,
+	0x3b, 0x90, 0x81, 0xb4, 0x7a, 0xd7, 0xfc, 0x2a, 0xad, 0xbd, 0xaf, 0x1b, 0x68, 0x7b, 0x6c, 0x5d,
+	0x17, 0x56, 0x86, 0x9f, 0xa3, 0x9d, 0x65, 0x44, 0xcb, 0x20, 0xca, 0x7c, 0x5f, 0x11, 0xa7, 0xeb,
+	0xf4, 0xb7, 0x26, 0xdb, 0xcb, 0xa8, 0xd4, 0xbd, 0xf5, 0x7d, 0x85, 0x4f, 0x50, 0x3b, 0xd7, 0x90,
+	0xd1, 0x39, 0x28, 0x31, 0x15, 0xa0, 0xa8, 0x2f, 0x02, 0xc8, 0x35, 0x59, 0xb1, 0x5a, 0x6c, 0xb8,
+	0x8f, 0x15, 0x35, 0xb4, 0x8c, 0x75, 0x44, 0xe2, 0x77, 0xc7, 0x6a, 0xe5, 0x88, 0xc4, 0xaf, 0x8e,
+	0x67, 0xa8, 0x59, 0x6d, 0x43, 0x17, 0x19, 0x90, 0x35, 0x2b, 0x44, 0x25, 0x74, 0x53, 0x64, 0x80,
+	0x5f, 0xa2, 0x5d, 0xad, 0x66, 0xb9, 0x16, 0x69, 0x40, 0x33, 0x50, 0x42, 0xfa, 0xe4, 0x1f, 0x2b,
+	0xda, 0x79, 0x84, 0xc7, 0x16, 0xc5, 0x1c, 0x1d, 0x2a, 0x98, 0x2a, 0xc8, 0x43, 0xaa, 0x43, 0xf3,
+	0x91, 0xb1, 0x4f, 0x15, 0xd3, 0x40, 0xd6, 0xbb, 0x4e, 0xbf, 0x79, 0x7a, 0x3c, 0xf8, 0x83, 0x02,
+	0x07, 0xef, 0x14, 0xe3, 0x5a, 0xc8, 0x74, 0xd2, 0xae, 0xc2, 0x6e, 0x1e, 0xb3, 0x26, 0x4c, 0x03,
+	0x3e, 0x42, 0xad, 0xcf, 0x42, 0xa7, 0x90, 0xe7, 0xb6, 0xb6, 0x9c, 0x6c, 0x74, 0x57, 0x4d, 0x6f,
+	0x15, 0x68, 0x6a, 0xcb, 0xb1, 0x8b, 0x0e, 0x14, 0x64, 0x52, 0x69, 0x9a, 0x88, 0xdc, 0x83, 0x90,
+	0xcd, 0x85, 0x9c, 0x29, 0xb2, 0xd9, 0x75, 0xfa, 0x9b, 0x13, 0x5c, 0x52, 0x57, 0x35, 0x06, 0xbf,
+	0x42, 0xfb, 0x65, 0x63, 0x9c, 0x99, 0xb5, 0x69, 0x22, 0x7d, 0x20, 0x5b, 0xf6, 0x94, 0x7b, 0x75,
+	0xe2, 0x4a, 0xfa, 0x80, 0x3f, 0xa0, 0xa6, 0x3d, 0x39, 0x8d, 0x61, 0x0e, 0x31, 0x41, 0x7f, 0x73,
+	0x38, 0x64, 0x13, 0x46, 0x26, 0x00, 0xbf, 0x40, 0xbb, 0x09, 0x5b, 0x50, 0x1e, 0x4b, 0x1e, 0x51,
+	0x5f, 0x89, 0xa9, 0x26, 0x4d, 0xbb, 0x74, 0x2b, 0x61, 0x8b, 0x0b, 0x83, 0x0e, 0x0d, 0x88, 0x7b,
+	0xc8, 0x00, 0xd4, 0xb3, 0xba, 0x98, 0x05, 0x64, 0xdb, 0xaa, 0x9a, 0x09, 0x5b, 0x9c, 0x1b, 0x6c,
+	0xc4, 0x02, 0x93, 0x15, 0x8b, 0x20, 0xd4, 0x34, 0x96, 0x41, 0xb5, 0xbf, 0x56, 0x99, 0x65, 0xe1,
+	0x91, 0x0c, 0xca, 0x35, 0x7b, 0xa8, 0x25, 0x3c, 0x4e, 0x73, 0x2d, 0x15, 0xd0, 0x08, 0x0a, 0xb2,
+	0x53, 0x66, 0x09, 0x8f, 0x5f, 0x1b, 0xec, 0x12, 0x0a, 0x53, 0x0a, 0x97, 0x49, 0x22, 0x74, 0x02,
+	0xa9, 0xa6, 0x99, 0x82, 0xa9, 0x58, 0x90, 0xdd, 0xb2, 0x94, 0x27, 0x62, 0x6c, 0xf1, 0x6a, 0x8c,
+	0xe4, 0x94, 0xe6, 0x19, 0xf0, 0x9c, 0xec, 0x75, 0x57, 0xab, 0x31, 0x92, 0xd3, 0x6b, 0x83, 0x98,
+	0x89, 0x0f, 0x52, 0xa6, 0x22, 0xea, 0x33, 0xcd, 0xa8, 0x2f, 0x14, 0xd9, 0x2f, 0x27, 0xde, 0xa2,
+	0x43, 0xa6, 0xd9, 0x50, 0x28, 0xfc, 0x1a, 0x61, 0xdb, 0x77, 0x61, 0xa6, 0x2d, 0x82, 0x82, 0x66,
+	0x4c, 0x87, 0x04, 0xd7, 0x6e, 0xc2, 0x30, 0x97, 0x50, 0x8c, 0x99, 0x0e, 0xf1, 0x1b, 0x44, 0x6c,
+	0xdb, 0x34, 0xd7, 0x4c, 0x43, 0x4e, 0xb9, 0x4c, 0xf9, 0x4c, 0x29, 0x48, 0x79, 0x41, 0x0e, 0xba,
+	0x4e, 0xbf, 0x35, 0x39, 0xb4, 0xfc, 0xb5, 0xa5, 0x2f, 0x9e, 0x58, 0x7c, 0x86, 0xfe, 0xad, 0x8f,
+	0x06, 0x8d, 0xa5, 0x8c, 0x3c, 0xc6, 0x23, 0xd2, 0xb6, 0xb6, 0x76, 0x9d, 0x1c, 0x55, 0x5c, 0xef,
+	0x3d, 0xda, 0x7c, 0xbc, 0x40, 0xfc, 0x3f, 0xda, 0x4a, 0x67, 0x09, 0x28, 0xa6, 0x65, 0xf9, 0xdf,
+	0x5d, 0x9b, 0x3c, 0x01, 0xb8, 0x8b, 0x9a, 0x3e, 0xa4, 0x32, 0x11, 0xa9, 0xe5, 0x57, 0x2c, 0x5f,
+	0x87, 0xce, 0xc7, 0xb7, 0x3f, 0x3a, 0x8d, 0xdb, 0xfb, 0x8e, 0x73, 0x77, 0xdf, 0x71, 0xbe, 0xdf,
+	0x77, 0x9c, 0x2f, 0x0f, 0x9d, 0xc6, 0xdd, 0x43, 0xa7, 0xf1, 0xed, 0xa1, 0xd3, 0xf8, 0x74, 0x1a,
+	0x08, 0x1d, 0xce, 0xbc, 0x01, 0x97, 0x89, 0x6b, 0xfa, 0xe2, 0x21, 0x13, 0x69, 0xcc, 0xbc, 0xda,
+	0x23, 0x75, 0xbc, 0x8c, 0x8e, 0x85, 0xc7, 0xdd, 0x40, 0xba, 0x76, 0xf2, 0xbc, 0x75, 0xfb, 0xd4,
+	0x9c, 0xfd, 0x1c, 0x00, 0x7c, 0xb5, 0x1e, 0x78, 0xd9, 0x04, 0x00, 0x00,
 }
 
 func (m *ProverConfig) Marshal() (dAtA []byte, err error) {
@@ -115,6 +194,134 @@ func (m *ProverConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MisbehaviourLookback != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.MisbehaviourLookback))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.ProveStatesConcurrency != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.ProveStatesConcurrency))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.VerifyingKeyPath) > 0 {
+		i -= len(m.VerifyingKeyPath)
+		copy(dAtA[i:], m.VerifyingKeyPath)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.VerifyingKeyPath)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.GnarkDataDir) > 0 {
+		i -= len(m.GnarkDataDir)
+		copy(dAtA[i:], m.GnarkDataDir)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.GnarkDataDir)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.ProofSpecs) > 0 {
+		for iNdEx := len(m.ProofSpecs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProofSpecs[iNdEx])
+			copy(dAtA[i:], m.ProofSpecs[iNdEx])
+			i = encodeVarintConfig(dAtA, i, uint64(len(m.ProofSpecs[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.CommitmentPrefix) > 0 {
+		i -= len(m.CommitmentPrefix)
+		copy(dAtA[i:], m.CommitmentPrefix)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.CommitmentPrefix)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.IbcStoreKey) > 0 {
+		i -= len(m.IbcStoreKey)
+		copy(dAtA[i:], m.IbcStoreKey)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.IbcStoreKey)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.LightLogLevel) > 0 {
+		i -= len(m.LightLogLevel)
+		copy(dAtA[i:], m.LightLogLevel)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.LightLogLevel)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.MaxBlockLag) > 0 {
+		i -= len(m.MaxBlockLag)
+		copy(dAtA[i:], m.MaxBlockLag)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.MaxBlockLag)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.MaxClockDrift) > 0 {
+		i -= len(m.MaxClockDrift)
+		copy(dAtA[i:], m.MaxClockDrift)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.MaxClockDrift)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.TrustLevel != nil {
+		{
+			size, err := m.TrustLevel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConfig(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.VerificationMode) > 0 {
+		i -= len(m.VerificationMode)
+		copy(dAtA[i:], m.VerificationMode)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.VerificationMode)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ReportMisbehaviour {
+		i--
+		if m.ReportMisbehaviour {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.WitnessAddrs) > 0 {
+		for iNdEx := len(m.WitnessAddrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WitnessAddrs[iNdEx])
+			copy(dAtA[i:], m.WitnessAddrs[iNdEx])
+			i = encodeVarintConfig(dAtA, i, uint64(len(m.WitnessAddrs[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.RefreshThresholdRate != nil {
+		{
+			size, err := m.RefreshThresholdRate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConfig(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.TrustingPeriod) > 0 {
 		i -= len(m.TrustingPeriod)
 		copy(dAtA[i:], m.TrustingPeriod)
@@ -153,6 +360,39 @@ func (m *ProverConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Fraction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fraction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Fraction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Denominator != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.Denominator))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Numerator != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.Numerator))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintConfig(dAtA []byte, offset int, v uint64) int {
 	offset -= sovConfig(v)
 	base := offset
@@ -190,6 +430,82 @@ func (m *ProverConfig) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovConfig(uint64(l))
 	}
+	if m.RefreshThresholdRate != nil {
+		l = m.RefreshThresholdRate.Size()
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	if len(m.WitnessAddrs) > 0 {
+		for _, s := range m.WitnessAddrs {
+			l = len(s)
+			n += 1 + l + sovConfig(uint64(l))
+		}
+	}
+	if m.ReportMisbehaviour {
+		n += 2
+	}
+	l = len(m.VerificationMode)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	if m.TrustLevel != nil {
+		l = m.TrustLevel.Size()
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.MaxClockDrift)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.MaxBlockLag)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.LightLogLevel)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.IbcStoreKey)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.CommitmentPrefix)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	if len(m.ProofSpecs) > 0 {
+		for _, s := range m.ProofSpecs {
+			l = len(s)
+			n += 2 + l + sovConfig(uint64(l))
+		}
+	}
+	l = len(m.GnarkDataDir)
+	if l > 0 {
+		n += 2 + l + sovConfig(uint64(l))
+	}
+	l = len(m.VerifyingKeyPath)
+	if l > 0 {
+		n += 2 + l + sovConfig(uint64(l))
+	}
+	if m.ProveStatesConcurrency != 0 {
+		n += 2 + sovConfig(uint64(m.ProveStatesConcurrency))
+	}
+	if m.MisbehaviourLookback != 0 {
+		n += 2 + sovConfig(uint64(m.MisbehaviourLookback))
+	}
+	return n
+}
+
+func (m *Fraction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Numerator != 0 {
+		n += 1 + sovConfig(uint64(m.Numerator))
+	}
+	if m.Denominator != 0 {
+		n += 1 + sovConfig(uint64(m.Denominator))
+	}
 	return n
 }
 
@@ -388,6 +704,544 @@ func (m *ProverConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.TrustingPeriod = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshThresholdRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RefreshThresholdRate == nil {
+				m.RefreshThresholdRate = &Fraction{}
+			}
+			if err := m.RefreshThresholdRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WitnessAddrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WitnessAddrs = append(m.WitnessAddrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportMisbehaviour", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReportMisbehaviour = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustLevel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TrustLevel == nil {
+				m.TrustLevel = &Fraction{}
+			}
+			if err := m.TrustLevel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClockDrift", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxClockDrift = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockLag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxBlockLag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LightLogLevel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LightLogLevel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcStoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcStoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitmentPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitmentPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofSpecs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofSpecs = append(m.ProofSpecs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GnarkDataDir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GnarkDataDir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyingKeyPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifyingKeyPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProveStatesConcurrency", wireType)
+			}
+			m.ProveStatesConcurrency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProveStatesConcurrency |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MisbehaviourLookback", wireType)
+			}
+			m.MisbehaviourLookback = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MisbehaviourLookback |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Fraction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fraction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fraction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Numerator", wireType)
+			}
+			m.Numerator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Numerator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denominator", wireType)
+			}
+			m.Denominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Denominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfig(dAtA[iNdEx:])
@@ -0,0 +1,70 @@
+package relay
+
+import "testing"
+
+func TestProverConfigValidate(t *testing.T) {
+	base := func() ProverConfig {
+		return ProverConfig{
+			ProverType:         "mock",
+			StepVerifierDigest: "0x09bf185e9e478bac323981a844afe484dcd73823f6a34f5adb8cffe6c4436111",
+			SkipVerifierDigest: "0x286fd609266936f71d552671b7553f1a0e59c7cf296112996bded1ca3bafa4a4",
+			TrustingPeriod:     "336h",
+		}
+	}
+	cases := []struct {
+		name   string
+		modify func(c *ProverConfig)
+		ok     bool
+	}{
+		{"default", func(c *ProverConfig) {}, true},
+		{"groth16", func(c *ProverConfig) { c.ProverType = "groth16" }, true},
+		{"groth16-compressed", func(c *ProverConfig) { c.ProverType = "groth16-compressed" }, true},
+		{"mock-groth16", func(c *ProverConfig) { c.ProverType = "mock-groth16" }, true},
+		{"mock with verifying key", func(c *ProverConfig) { c.VerifyingKeyPath = "/data/vk.bin" }, false},
+		{"mock-groth16 with verifying key", func(c *ProverConfig) { c.ProverType = "mock-groth16"; c.VerifyingKeyPath = "/data/vk.bin" }, false},
+		{"groth16-embedded", func(c *ProverConfig) { c.ProverType = "groth16-embedded"; c.GnarkDataDir = "/data" }, true},
+		{"groth16-embedded without data dir", func(c *ProverConfig) { c.ProverType = "groth16-embedded" }, false},
+		{"groth16-commitment with verifying key", func(c *ProverConfig) { c.ProverType = "groth16-commitment"; c.VerifyingKeyPath = "/data/vk.bin" }, true},
+		{"unknown prover type", func(c *ProverConfig) { c.ProverType = "plonk" }, false},
+		{"refresh threshold rate", func(c *ProverConfig) { c.RefreshThresholdRate = &Fraction{Numerator: 1, Denominator: 2} }, true},
+		{"refresh threshold rate > 1", func(c *ProverConfig) { c.RefreshThresholdRate = &Fraction{Numerator: 3, Denominator: 2} }, false},
+		{"refresh threshold rate zero denominator", func(c *ProverConfig) { c.RefreshThresholdRate = &Fraction{Numerator: 1} }, false},
+		{"witness addrs", func(c *ProverConfig) { c.WitnessAddrs = []string{"http://localhost:26657"} }, true},
+		{"invalid witness addr", func(c *ProverConfig) { c.WitnessAddrs = []string{"localhost"} }, false},
+		{"sequential", func(c *ProverConfig) { c.VerificationMode = VerificationModeSequential }, true},
+		{"invalid verification mode", func(c *ProverConfig) { c.VerificationMode = "bisection" }, false},
+		{"trust level", func(c *ProverConfig) { c.TrustLevel = &Fraction{Numerator: 2, Denominator: 3} }, true},
+		{"trust level < 1/3", func(c *ProverConfig) { c.TrustLevel = &Fraction{Numerator: 1, Denominator: 4} }, false},
+		{"max clock drift", func(c *ProverConfig) { c.MaxClockDrift = "10s" }, true},
+		{"invalid max block lag", func(c *ProverConfig) { c.MaxBlockLag = "10" }, false},
+		{"light log level", func(c *ProverConfig) { c.LightLogLevel = "debug" }, true},
+		{"invalid light log level", func(c *ProverConfig) { c.LightLogLevel = "trace" }, false},
+		{"proof specs", func(c *ProverConfig) { c.ProofSpecs = []string{"smt", "tendermint"} }, true},
+		{"unknown proof spec", func(c *ProverConfig) { c.ProofSpecs = []string{"jmt", "tendermint"} }, false},
+		{"too many proof specs", func(c *ProverConfig) { c.ProofSpecs = []string{"iavl", "iavl", "tendermint"} }, false},
+	}
+	for _, c := range cases {
+		cfg := base()
+		c.modify(&cfg)
+		if err := cfg.Validate(); c.ok != (err == nil) {
+			t.Errorf("%s: unexpected result: %v", c.name, err)
+		}
+	}
+}
+
+func TestProverConfigGetStoreLayout(t *testing.T) {
+	if layout := (ProverConfig{}).GetStoreLayout(); layout != DefaultStoreLayout {
+		t.Fatalf("unexpected default layout: %v", layout)
+	}
+	layout := ProverConfig{IbcStoreKey: "ibc2", ProofSpecs: []string{"smt", "tendermint"}}.GetStoreLayout()
+	if layout.StoreKey != "ibc2" || layout.CommitmentPrefix != "ibc2" {
+		t.Fatalf("unexpected store key or commitment prefix: %v", layout)
+	}
+	if layout.Specs[0].Spec != SMTSpec || layout.Specs[1].Spec != SimpleTree {
+		t.Fatalf("unexpected specs: %v", layout.Specs)
+	}
+	layout = ProverConfig{IbcStoreKey: "ibc2", CommitmentPrefix: "ibc"}.GetStoreLayout()
+	if layout.StoreKey != "ibc2" || layout.CommitmentPrefix != "ibc" {
+		t.Fatalf("unexpected store key or commitment prefix: %v", layout)
+	}
+}
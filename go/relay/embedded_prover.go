@@ -0,0 +1,115 @@
+package relay
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp"
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp/groth16"
+	"github.com/datachainlab/tendermint-zk-ibc/go/wrapper"
+)
+
+// EmbeddedProverType runs the gnark prover of `Plonky2xVerifierCircuit` in the relayer process.
+// The proofs have the same format as `groth16`, so the circuit must be set up with the `groth16` proving system.
+const EmbeddedProverType = "groth16-embedded"
+
+func init() {
+	ps, err := zkp.Get(groth16.Groth16ProverType)
+	if err != nil {
+		panic(err)
+	}
+	ps.Name = EmbeddedProverType
+	ps.ParseProof = func(bz []byte) (zkp.ZKProof, error) {
+		p, err := groth16.ParseGroth16Proof(bz)
+		if err != nil {
+			return nil, err
+		}
+		return embeddedProof{*p}, nil
+	}
+	ps.DecodeEthABI = func(bz []byte) (zkp.ZKProof, error) {
+		p, err := groth16.EthABIDecodeGroth16Proof(bz)
+		if err != nil {
+			return nil, err
+		}
+		return embeddedProof{p}, nil
+	}
+	ps.Prove = proveEmbedded
+	zkp.Register(ps)
+}
+
+// embeddedProof is a Groth16 proof generated by the embedded prover.
+// It is stored with `EmbeddedProverType` so that the proof store finds it for the configured prover type.
+type embeddedProof struct {
+	groth16.Groth16Proof
+}
+
+var _ zkp.ZKProof = (*embeddedProof)(nil)
+
+func (p embeddedProof) ProverType() string {
+	return EmbeddedProverType
+}
+
+// embeddedProver is implemented by `wrapper.Prover`
+type embeddedProver interface {
+	Prove(req wrapper.ProveRequest) (*wrapper.InputsAndProof, error)
+}
+
+// embeddedProvers caches the loader of the gnark prover by the data directory
+var embeddedProvers sync.Map
+
+// getEmbeddedProver loads the r1cs and the proving key in `dataDir` on the first call
+func getEmbeddedProver(dataDir string) (embeddedProver, error) {
+	load, _ := embeddedProvers.LoadOrStore(dataDir, sync.OnceValues(func() (embeddedProver, error) {
+		return wrapper.NewProver(dataDir)
+	}))
+	return load.(func() (embeddedProver, error))()
+}
+
+// proveEmbedded requests the plonky2 proof to the zk prover and wraps it in a Groth16 proof in-process
+func proveEmbedded(req zkp.ProveRequest) (zkp.ZKProof, []*big.Int, error) {
+	if req.DataDir == "" {
+		return nil, nil, fmt.Errorf("gnark data directory is not configured")
+	}
+
+	url := fmt.Sprintf("%s/plonky2_proof?trusted_height=%d&target_height=%d", req.ProverAddress, req.TrustedHeight, req.TargetHeight)
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("failed to get plonky2 proof: status=%v", resp.Status)
+	}
+	var pr wrapper.ProveRequest
+	if err := json.NewDecoder(resp.Body).Decode(&pr); err != nil {
+		return nil, nil, err
+	}
+
+	prover, err := getEmbeddedProver(req.DataDir)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load gnark prover: %w", err)
+	}
+	res, err := prover.Prove(pr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to prove: %w", err)
+	}
+	p, err := groth16.ParseGroth16Proof(res.Proof)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse proof: %w", err)
+	}
+	return embeddedProof{*p}, res.Input[:], nil
+}
+
+// checkInputs checks that the public inputs of the proof equal the ones computed from the headers
+func checkInputs(expected, actual [3]HexBigInt) error {
+	for i := range expected {
+		e, a := big.Int(expected[i]), big.Int(actual[i])
+		if e.Cmp(&a) != 0 {
+			return fmt.Errorf("input mismatch(%v): expected=%v actual=%v", i, &e, &a)
+		}
+	}
+	return nil
+}
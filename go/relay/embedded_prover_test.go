@@ -0,0 +1,167 @@
+package relay
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp"
+	"github.com/datachainlab/tendermint-zk-ibc/go/wrapper"
+)
+
+// testEmbeddedProver returns the configured result instead of proving
+type testEmbeddedProver struct {
+	res *wrapper.InputsAndProof
+	err error
+}
+
+func (p testEmbeddedProver) Prove(req wrapper.ProveRequest) (*wrapper.InputsAndProof, error) {
+	return p.res, p.err
+}
+
+// newTestEmbeddedProverDir registers `prover` for a new data directory and returns it
+func newTestEmbeddedProverDir(t *testing.T, prover embeddedProver) string {
+	dir := t.TempDir()
+	embeddedProvers.Store(dir, func() (embeddedProver, error) { return prover, nil })
+	t.Cleanup(func() { embeddedProvers.Delete(dir) })
+	return dir
+}
+
+// newTestPlonky2ProofServer serves `/plonky2_proof` with the status and the body
+func newTestPlonky2ProofServer(t *testing.T, status int, body []byte) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/plonky2_proof" || r.URL.Query().Get("trusted_height") != "10" || r.URL.Query().Get("target_height") != "20" {
+			http.NotFound(w, r)
+			return
+		}
+		w.WriteHeader(status)
+		w.Write(body)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestProveEmbedded(t *testing.T) {
+	_, input, proof := newTestGroth16VerifyingKey(t)
+	solidityProof := marshalSolidityGroth16Proof(t, proof)
+	reqJSON, err := json.Marshal(wrapper.ProveRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok := newTestPlonky2ProofServer(t, http.StatusOK, reqJSON)
+	res := &wrapper.InputsAndProof{Input: input, Proof: solidityProof}
+
+	cases := []struct {
+		name string
+		addr string
+		dir  string
+		ok   bool
+	}{
+		{"valid", ok.URL, newTestEmbeddedProverDir(t, testEmbeddedProver{res: res}), true},
+		{"no data directory", ok.URL, "", false},
+		{"prover error", ok.URL, newTestEmbeddedProverDir(t, testEmbeddedProver{err: errors.New("failed")}), false},
+		{"invalid proof", ok.URL, newTestEmbeddedProverDir(t, testEmbeddedProver{res: &wrapper.InputsAndProof{Input: input, Proof: solidityProof[1:]}}), false},
+		{"no setup", ok.URL, t.TempDir(), false},
+		{"plonky2 proof not found", newTestPlonky2ProofServer(t, http.StatusInternalServerError, nil).URL, newTestEmbeddedProverDir(t, testEmbeddedProver{res: res}), false},
+		{"invalid plonky2 proof", newTestPlonky2ProofServer(t, http.StatusOK, []byte("{")).URL, newTestEmbeddedProverDir(t, testEmbeddedProver{res: res}), false},
+	}
+	for _, c := range cases {
+		p, proofInput, err := proveEmbedded(zkp.ProveRequest{TrustedHeight: 10, TargetHeight: 20, ProverAddress: c.addr, DataDir: c.dir})
+		if c.ok != (err == nil) {
+			t.Errorf("%s: unexpected result: %v", c.name, err)
+			continue
+		} else if err != nil {
+			continue
+		}
+		if p.ProverType() != EmbeddedProverType || !bytes.Equal(p.EncodeEthABI(), proof) {
+			t.Errorf("%s: unexpected proof: %v", c.name, p)
+		}
+		for i := range input {
+			if proofInput[i].Cmp(input[i]) != 0 {
+				t.Errorf("%s: unexpected input[%d]: %v", c.name, i, proofInput[i])
+			}
+		}
+	}
+}
+
+func TestZKProverClientProveEmbedded(t *testing.T) {
+	reqJSON, err := json.Marshal(wrapper.ProveRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := newTestPlonky2ProofServer(t, http.StatusOK, reqJSON)
+	_, _, proof := newTestGroth16VerifyingKey(t)
+	solidityProof := marshalSolidityGroth16Proof(t, proof)
+
+	prover := &testEmbeddedProver{}
+	zpc := newTestZKProverClient(EmbeddedProverType, srv.URL)
+	zpc.GnarkDataDir = newTestEmbeddedProverDir(t, prover)
+	expected := testInputs(zpc, 10, 20)
+	prover.res = &wrapper.InputsAndProof{Proof: solidityProof}
+	for i := range expected {
+		prover.res.Input[i] = (*big.Int)(&expected[i])
+	}
+
+	pi, err := zpc.Prove(10, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the proof is stored and found with the configured prover type
+	store := NewProofStore(filepath.Join(t.TempDir(), "proofs", "ibc0"))
+	if err := store.Put(NewProofArtifact(10, 20, pi)); err != nil {
+		t.Fatal(err)
+	}
+	a, err := store.Latest(10, EmbeddedProverType)
+	if err != nil || a == nil {
+		t.Fatalf("the stored proof is not found: %v %v", a, err)
+	}
+	stored, err := a.ZKProofAndInput()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := zpc.VerifyProof(10, 20, stored); err != nil {
+		t.Fatal(err)
+	}
+	if stored.Proof.ProverType() != EmbeddedProverType || !bytes.Equal(stored.Proof.EncodeEthABI(), proof) {
+		t.Errorf("unexpected stored proof: %v", stored.Proof)
+	}
+
+	// the inputs of the proof must be the ones computed from the headers
+	prover.res.Input[0] = big.NewInt(1)
+	if _, err := zpc.Prove(10, 20); err == nil {
+		t.Error("expected an error for the inputs that do not match the headers")
+	}
+}
+
+func TestCheckInputs(t *testing.T) {
+	newInputs := func(vs ...int64) [3]HexBigInt {
+		var res [3]HexBigInt
+		for i, v := range vs {
+			res[i] = HexBigInt(*big.NewInt(v))
+		}
+		return res
+	}
+	cases := []struct {
+		name     string
+		expected [3]HexBigInt
+		actual   [3]HexBigInt
+		ok       bool
+	}{
+		{"equal", newInputs(1, 2, 3), newInputs(1, 2, 3), true},
+		{"zero", newInputs(), newInputs(0, 0, 0), true},
+		{"verifier digest mismatch", newInputs(1, 2, 3), newInputs(4, 2, 3), false},
+		{"input hash mismatch", newInputs(1, 2, 3), newInputs(1, 4, 3), false},
+		{"output hash mismatch", newInputs(1, 2, 3), newInputs(1, 2, 4), false},
+		{"swapped", newInputs(1, 2, 3), newInputs(2, 1, 3), false},
+	}
+	for _, c := range cases {
+		if err := checkInputs(c.expected, c.actual); c.ok != (err == nil) {
+			t.Errorf("%s: unexpected result: %v", c.name, err)
+		}
+	}
+}
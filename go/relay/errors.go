@@ -0,0 +1,56 @@
+package relay
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrConsensusStateMismatch is returned when a consensus state stored in the counterparty client
+// differs from the header verified by the local light client, which indicates a fork or misbehaviour
+var ErrConsensusStateMismatch = errors.New("counterparty consensus state mismatch")
+
+// ErrNoCommonTrustedHeight is returned when no light block below the misbehaviour height is stored as a consensus state in the counterparty client,
+// so the relayer cannot prove the headers to report the misbehaviour
+var ErrNoCommonTrustedHeight = errors.New("no common trusted height")
+
+// DivergenceError is returned when the local light client detects that the primary and a witness provide conflicting headers.
+// Height is the height of the header from the primary that conflicts with the one from the witness.
+type DivergenceError struct {
+	Height int64
+	Reason error
+}
+
+func (e *DivergenceError) Error() string {
+	return fmt.Sprintf("the primary and a witness diverge: height=%d %v", e.Height, e.Reason)
+}
+
+func (e *DivergenceError) Unwrap() error {
+	return e.Reason
+}
+
+// ErrNilHeader is returned when a nil header is passed to the header proof helpers
+var ErrNilHeader = errors.New("header is nil")
+
+// HeaderFieldError is returned when a header field cannot be encoded into a leaf of the header hash tree
+type HeaderFieldError struct {
+	Field string
+	Err   error
+}
+
+func (e *HeaderFieldError) Error() string {
+	return fmt.Sprintf("failed to encode the header field: field=%v %v", e.Field, e.Err)
+}
+
+func (e *HeaderFieldError) Unwrap() error {
+	return e.Err
+}
+
+// HeaderHashMismatchError is returned when the root recomputed from the header fields differs from `Header.Hash`
+type HeaderHashMismatchError struct {
+	Expected []byte
+	Actual   []byte
+}
+
+func (e *HeaderHashMismatchError) Error() string {
+	return fmt.Sprintf("header hash mismatch: expected=%X actual=%X", e.Expected, e.Actual)
+}
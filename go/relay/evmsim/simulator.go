@@ -0,0 +1,208 @@
+package evmsim
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const gasLimit = 30_000_000
+
+// simulatedChainID is the chain ID of `backends.SimulatedBackend`
+var simulatedChainID = big.NewInt(1337)
+
+// Artifact is a contract compiled by forge, e.g. out/TendermintZKLightClientGroth16.sol/TendermintZKLightClientGroth16.json
+type Artifact struct {
+	ABI      abi.ABI
+	Bytecode []byte
+}
+
+// LoadArtifact loads the ABI and the creation bytecode from the forge artifact at `path`
+func LoadArtifact(path string) (*Artifact, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var a struct {
+		ABI      json.RawMessage `json:"abi"`
+		Bytecode struct {
+			Object string `json:"object"`
+		} `json:"bytecode"`
+	}
+	if err := json.Unmarshal(bz, &a); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the artifact: %w", err)
+	}
+	contractABI, err := abi.JSON(strings.NewReader(string(a.ABI)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the ABI: %w", err)
+	}
+	bytecode, err := hexutil.Decode(a.Bytecode.Object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the bytecode: %w", err)
+	}
+	if len(bytecode) == 0 {
+		return nil, fmt.Errorf("the artifact has no bytecode: %v", path)
+	}
+	return &Artifact{ABI: contractABI, Bytecode: bytecode}, nil
+}
+
+// Result is the result of a simulated transaction
+type Result struct {
+	GasUsed uint64
+	// CalldataGas is the part of the intrinsic gas charged for the calldata
+	CalldataGas  uint64
+	Reverted     bool
+	RevertReason string
+}
+
+// Simulator deploys a tendermint-zk light client contract on a simulated EVM chain.
+// The sender of the transactions is registered as the IBC handler of the light client.
+type Simulator struct {
+	backend  *backends.SimulatedBackend
+	key      *ecdsa.PrivateKey
+	from     common.Address
+	artifact *Artifact
+	address  common.Address
+}
+
+// NewSimulator deploys the light client of `artifact` with the verifier digests
+func NewSimulator(artifact *Artifact, stepVerifierDigest, skipVerifierDigest []byte, revisionNumber uint64) (*Simulator, error) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	balance := new(big.Int).Lsh(big.NewInt(1), 100)
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{from: {Balance: balance}}, gasLimit)
+
+	opts, err := bind.NewKeyedTransactorWithChainID(key, simulatedChainID)
+	if err != nil {
+		return nil, err
+	}
+	address, _, _, err := bind.DeployContract(
+		opts, artifact.ABI, artifact.Bytecode, backend,
+		from, new(big.Int).SetBytes(stepVerifierDigest), new(big.Int).SetBytes(skipVerifierDigest), revisionNumber,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deploy the light client: %w", err)
+	}
+	backend.Commit()
+	return &Simulator{backend: backend, key: key, from: from, artifact: artifact, address: address}, nil
+}
+
+// Close stops the simulated backend
+func (s *Simulator) Close() error {
+	return s.backend.Close()
+}
+
+// InitializeClient calls `initializeClient` with the proto-encoded client state and consensus state
+func (s *Simulator) InitializeClient(clientID string, protoClientState, protoConsensusState []byte) (*Result, error) {
+	data, err := s.artifact.ABI.Pack("initializeClient", clientID, protoClientState, protoConsensusState)
+	if err != nil {
+		return nil, err
+	}
+	return s.transact(data)
+}
+
+// UpdateClient calls the function returned by `routeUpdateClient` in the same way as `IBCHandler.updateClient`
+func (s *Simulator) UpdateClient(clientID string, protoClientMessage []byte) (*Result, error) {
+	out, err := s.call("routeUpdateClient", clientID, protoClientMessage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to route the client message: %w", err)
+	}
+	selector, args := out[0].([4]byte), out[1].([]byte)
+	return s.transact(append(selector[:], args...))
+}
+
+func (s *Simulator) call(method string, args ...interface{}) ([]interface{}, error) {
+	data, err := s.artifact.ABI.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := s.backend.CallContract(context.TODO(), ethereum.CallMsg{From: s.from, To: &s.address, Data: data}, nil)
+	if err != nil {
+		return nil, s.revertError(err)
+	}
+	return s.artifact.ABI.Unpack(method, ret)
+}
+
+func (s *Simulator) transact(data []byte) (*Result, error) {
+	ctx := context.TODO()
+	nonce, err := s.backend.PendingNonceAt(ctx, s.from)
+	if err != nil {
+		return nil, err
+	}
+	gasPrice, err := s.backend.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := types.SignTx(
+		types.NewTransaction(nonce, s.address, new(big.Int), gasLimit, gasPrice, data),
+		types.LatestSignerForChainID(simulatedChainID),
+		s.key,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.backend.SendTransaction(ctx, tx); err != nil {
+		return nil, err
+	}
+	s.backend.Commit()
+	receipt, err := s.backend.TransactionReceipt(ctx, tx.Hash())
+	if err != nil {
+		return nil, err
+	}
+	res := Result{GasUsed: receipt.GasUsed, CalldataGas: relay.CalldataGas(data)}
+	if receipt.Status == types.ReceiptStatusFailed {
+		res.Reverted = true
+		// replay the transaction to get the revert data
+		_, err := s.backend.CallContract(ctx, ethereum.CallMsg{From: s.from, To: &s.address, Gas: gasLimit, Data: data}, nil)
+		if err != nil {
+			res.RevertReason = s.revertError(err).Error()
+		} else {
+			res.RevertReason = "unknown"
+		}
+	}
+	return &res, nil
+}
+
+// revertError decodes the revert data in `err` with the custom errors of the artifact
+func (s *Simulator) revertError(err error) error {
+	var dataErr interface{ ErrorData() interface{} }
+	if !errors.As(err, &dataErr) {
+		return err
+	}
+	hexData, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return err
+	}
+	data, decodeErr := hexutil.Decode(hexData)
+	if decodeErr != nil || len(data) < 4 {
+		return err
+	}
+	if reason, unpackErr := abi.UnpackRevert(data); unpackErr == nil {
+		return fmt.Errorf("execution reverted: %v", reason)
+	}
+	for name, e := range s.artifact.ABI.Errors {
+		if string(e.ID[:4]) == string(data[:4]) {
+			return fmt.Errorf("execution reverted: %v", name)
+		}
+	}
+	return fmt.Errorf("execution reverted: %v", hexData)
+}
@@ -0,0 +1,81 @@
+package evmsim
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const testABI = `[
+	{"type": "constructor", "inputs": [
+		{"name": "ibcHandler_", "type": "address"},
+		{"name": "stepVerifierDigest_", "type": "uint256"},
+		{"name": "skipVerifierDigest_", "type": "uint256"},
+		{"name": "revisionNumber_", "type": "uint64"}
+	]},
+	{"type": "function", "name": "initializeClient", "stateMutability": "nonpayable", "inputs": [
+		{"name": "clientId", "type": "string"},
+		{"name": "protoClientState", "type": "bytes"},
+		{"name": "protoConsensusState", "type": "bytes"}
+	], "outputs": []},
+	{"type": "function", "name": "routeUpdateClient", "stateMutability": "pure", "inputs": [
+		{"name": "clientId", "type": "string"},
+		{"name": "protoClientMessage", "type": "bytes"}
+	], "outputs": [
+		{"name": "selector", "type": "bytes4"},
+		{"name": "args", "type": "bytes"}
+	]},
+	{"type": "error", "name": "ITendermintZKLightClientOnlyIBC", "inputs": []}
+]`
+
+// writeTestArtifact writes an artifact of a contract which always reverts with `ITendermintZKLightClientOnlyIBC()`
+func writeTestArtifact(t *testing.T) string {
+	selector := crypto.Keccak256([]byte("ITendermintZKLightClientOnlyIBC()"))[:4]
+	// PUSH4 selector PUSH1 0xe0 SHL PUSH1 0 MSTORE PUSH1 4 PUSH1 0 REVERT
+	runtime := append(append([]byte{0x63}, selector...), 0x60, 0xe0, 0x1b, 0x60, 0x00, 0x52, 0x60, 0x04, 0x60, 0x00, 0xfd)
+	// PUSH1 len(runtime) PUSH1 12 PUSH1 0 CODECOPY PUSH1 len(runtime) PUSH1 0 RETURN
+	initcode := []byte{0x60, byte(len(runtime)), 0x60, 0x0c, 0x60, 0x00, 0x39, 0x60, byte(len(runtime)), 0x60, 0x00, 0xf3}
+	bz, err := json.Marshal(map[string]interface{}{
+		"abi":      json.RawMessage(testABI),
+		"bytecode": map[string]string{"object": hexutil.Encode(append(initcode, runtime...))},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "TendermintZKLightClient.json")
+	if err := os.WriteFile(path, bz, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestSimulator(t *testing.T) {
+	artifact, err := LoadArtifact(writeTestArtifact(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewSimulator(artifact, make([]byte, 32), make([]byte, 32), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	res, err := s.InitializeClient("tendermint-zk-0", []byte{0x01}, []byte{0x02})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Reverted || !strings.Contains(res.RevertReason, "ITendermintZKLightClientOnlyIBC") {
+		t.Fatalf("unexpected result: %+v", res)
+	}
+	if res.GasUsed == 0 || res.CalldataGas == 0 {
+		t.Fatalf("unexpected gas: %+v", res)
+	}
+	if _, err := s.UpdateClient("tendermint-zk-0", []byte{0x01}); err == nil || !strings.Contains(err.Error(), "ITendermintZKLightClientOnlyIBC") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
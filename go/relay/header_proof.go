@@ -0,0 +1,209 @@
+package relay
+
+import (
+	"bytes"
+	"fmt"
+	"math/bits"
+
+	cometbfttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// indices of the header fields, which are the leaves of the simple tree of `Header.Hash`
+const (
+	HeaderFieldVersion uint8 = iota
+	HeaderFieldChainID
+	HeaderFieldHeight
+	HeaderFieldTime
+	HeaderFieldLastBlockID
+	HeaderFieldLastCommitHash
+	HeaderFieldDataHash
+	HeaderFieldValidatorsHash
+	HeaderFieldNextValidatorsHash
+	HeaderFieldConsensusHash
+	HeaderFieldAppHash
+	HeaderFieldLastResultsHash
+	HeaderFieldEvidenceHash
+	HeaderFieldProposerAddress
+
+	headerFieldsCount = 14
+)
+
+var headerFieldsProofABI, _ = abi.NewType("tuple", "HeaderFieldsProof", []abi.ArgumentMarshaling{
+	{Name: "fields", Type: "tuple[]", Components: []abi.ArgumentMarshaling{
+		{Name: "index", Type: "uint8"},
+		{Name: "value", Type: "bytes"},
+	}},
+	{Name: "siblings", Type: "bytes32[]"},
+})
+
+// HeaderField is an encoded header field and its index
+type HeaderField struct {
+	Index uint8  `json:"index"`
+	Value []byte `json:"value"`
+}
+
+// HeaderFieldsProof proves a subset of the header fields against the header hash.
+// `Fields` are sorted by the index, and `Siblings` are the roots of the subtrees which contain no proven fields in depth-first order.
+type HeaderFieldsProof struct {
+	Fields   []HeaderField `json:"fields"`
+	Siblings [][32]byte    `json:"siblings"`
+}
+
+// GetHeaderFieldsProof returns the proof of the header fields specified by `indices`
+func GetHeaderFieldsProof(h *cometbfttypes.Header, indices ...uint8) (*HeaderFieldsProof, error) {
+	fields, err := headerToFields(h)
+	if err != nil {
+		return nil, err
+	}
+	targets, err := headerFieldTargets(indices)
+	if err != nil {
+		return nil, err
+	}
+	var proof HeaderFieldsProof
+	for i, ok := range targets {
+		if ok {
+			proof.Fields = append(proof.Fields, HeaderField{Index: uint8(i), Value: fields[i]})
+		}
+	}
+	var prove func(lo, hi int) []byte
+	prove = func(lo, hi int) []byte {
+		if !containsTarget(targets, lo, hi) {
+			root := simpleTreeRoot(fields[lo:hi])
+			proof.Siblings = append(proof.Siblings, [32]byte(root))
+			return root
+		} else if hi-lo == 1 {
+			return leafHash(fields[lo])
+		}
+		k := lo + splitPoint(hi-lo)
+		return innerHash(prove(lo, k), prove(k, hi))
+	}
+	if root, expected := prove(0, headerFieldsCount), h.Hash(); !bytes.Equal(root, expected) {
+		return nil, &HeaderHashMismatchError{Expected: expected, Actual: root}
+	}
+	return &proof, nil
+}
+
+// VerifyHeaderFieldsProof verifies that `proof` reconstructs the header hash `root`
+func VerifyHeaderFieldsProof(root []byte, proof *HeaderFieldsProof) error {
+	indices := make([]uint8, len(proof.Fields))
+	for i, f := range proof.Fields {
+		if i > 0 && f.Index <= indices[i-1] {
+			return fmt.Errorf("fields must be sorted by the index without duplicates")
+		}
+		indices[i] = f.Index
+	}
+	targets, err := headerFieldTargets(indices)
+	if err != nil {
+		return err
+	}
+	fields, siblings := proof.Fields, proof.Siblings
+	var compute func(lo, hi int) ([]byte, error)
+	compute = func(lo, hi int) ([]byte, error) {
+		if !containsTarget(targets, lo, hi) {
+			if len(siblings) == 0 {
+				return nil, fmt.Errorf("too few siblings")
+			}
+			s := siblings[0]
+			siblings = siblings[1:]
+			return s[:], nil
+		} else if hi-lo == 1 {
+			f := fields[0]
+			fields = fields[1:]
+			return leafHash(f.Value), nil
+		}
+		k := lo + splitPoint(hi-lo)
+		left, err := compute(lo, k)
+		if err != nil {
+			return nil, err
+		}
+		right, err := compute(k, hi)
+		if err != nil {
+			return nil, err
+		}
+		return innerHash(left, right), nil
+	}
+	h, err := compute(0, headerFieldsCount)
+	if err != nil {
+		return err
+	}
+	if len(siblings) != 0 {
+		return fmt.Errorf("too many siblings: %v", len(proof.Siblings))
+	}
+	if !bytes.Equal(h, root) {
+		return fmt.Errorf("header hash mismatch: expected=%X actual=%X", root, h)
+	}
+	return nil
+}
+
+func EthABIEncodeHeaderFieldsProof(proof *HeaderFieldsProof) ([]byte, error) {
+	packer := abi.Arguments{
+		{Type: headerFieldsProofABI},
+	}
+	return packer.Pack(proof)
+}
+
+func EthABIDecodeHeaderFieldsProof(data []byte) (*HeaderFieldsProof, error) {
+	packer := abi.Arguments{
+		{Type: headerFieldsProofABI},
+	}
+	v, err := packer.Unpack(data)
+	if err != nil {
+		return nil, err
+	}
+	return abi.ConvertType(v[0], new(HeaderFieldsProof)).(*HeaderFieldsProof), nil
+}
+
+func headerFieldTargets(indices []uint8) ([headerFieldsCount]bool, error) {
+	var targets [headerFieldsCount]bool
+	if len(indices) == 0 {
+		return targets, fmt.Errorf("no header fields are specified")
+	}
+	for _, i := range indices {
+		if i >= headerFieldsCount {
+			return targets, fmt.Errorf("invalid header field index: %v", i)
+		}
+		targets[i] = true
+	}
+	return targets, nil
+}
+
+func containsTarget(targets [headerFieldsCount]bool, lo, hi int) bool {
+	for i := lo; i < hi; i++ {
+		if targets[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// verifyHeaderLeaves checks that the root of `leaves` equals the header hash
+func verifyHeaderLeaves(h *cometbfttypes.Header, leaves [][]byte) error {
+	if root, expected := simpleTreeRootFromLeaves(leaves), h.Hash(); !bytes.Equal(root, expected) {
+		return &HeaderHashMismatchError{Expected: expected, Actual: root}
+	}
+	return nil
+}
+
+// simpleTreeRootFromLeaves returns the root of the simple tree of the hashed `leaves`
+func simpleTreeRootFromLeaves(leaves [][]byte) []byte {
+	if len(leaves) == 1 {
+		return leaves[0]
+	}
+	k := splitPoint(len(leaves))
+	return innerHash(simpleTreeRootFromLeaves(leaves[:k]), simpleTreeRootFromLeaves(leaves[k:]))
+}
+
+// simpleTreeRoot returns the root of the simple tree of `items` in the same way as `merkle.HashFromByteSlices`
+func simpleTreeRoot(items [][]byte) []byte {
+	if len(items) == 1 {
+		return leafHash(items[0])
+	}
+	k := splitPoint(len(items))
+	return innerHash(simpleTreeRoot(items[:k]), simpleTreeRoot(items[k:]))
+}
+
+// splitPoint returns the largest power of 2 less than `n`
+func splitPoint(n int) int {
+	return 1 << (bits.Len(uint(n-1)) - 1)
+}
@@ -0,0 +1,96 @@
+package relay
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	cometbfttypes "github.com/cometbft/cometbft/types"
+)
+
+func newTestHeader() *cometbfttypes.Header {
+	hash := func(s string) []byte { return tmhash.Sum([]byte(s)) }
+	return &cometbfttypes.Header{
+		Version: cmtversion.Consensus{Block: 11, App: 1},
+		ChainID: "ibc0",
+		Height:  100,
+		Time:    time.Unix(1700000000, 123456789).UTC(),
+		LastBlockID: cometbfttypes.BlockID{
+			Hash:          hash("last_block"),
+			PartSetHeader: cometbfttypes.PartSetHeader{Total: 1, Hash: hash("part_set")},
+		},
+		LastCommitHash:     hash("last_commit"),
+		DataHash:           hash("data"),
+		ValidatorsHash:     hash("validators"),
+		NextValidatorsHash: hash("next_validators"),
+		ConsensusHash:      hash("consensus"),
+		AppHash:            hash("app"),
+		LastResultsHash:    hash("last_results"),
+		EvidenceHash:       hash("evidence"),
+		ProposerAddress:    hash("proposer")[:20],
+	}
+}
+
+func TestHeaderFieldsProof(t *testing.T) {
+	h := newTestHeader()
+	for _, indices := range [][]uint8{
+		{HeaderFieldVersion},
+		{HeaderFieldProposerAddress},
+		{HeaderFieldChainID, HeaderFieldHeight},
+		{HeaderFieldTime, HeaderFieldAppHash},
+		{HeaderFieldValidatorsHash, HeaderFieldNextValidatorsHash, HeaderFieldEvidenceHash},
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13},
+	} {
+		proof, err := GetHeaderFieldsProof(h, indices...)
+		if err != nil {
+			t.Fatalf("%v: %v", indices, err)
+		}
+		bz, err := EthABIEncodeHeaderFieldsProof(proof)
+		if err != nil {
+			t.Fatalf("%v: %v", indices, err)
+		}
+		decoded, err := EthABIDecodeHeaderFieldsProof(bz)
+		if err != nil {
+			t.Fatalf("%v: %v", indices, err)
+		}
+		if err := VerifyHeaderFieldsProof(h.Hash(), decoded); err != nil {
+			t.Fatalf("%v: %v", indices, err)
+		}
+
+		decoded.Fields[0].Value = append(decoded.Fields[0].Value, 0)
+		if err := VerifyHeaderFieldsProof(h.Hash(), decoded); err == nil {
+			t.Fatalf("%v: expected an error for a tampered field", indices)
+		}
+	}
+
+	if _, err := GetHeaderFieldsProof(h); err == nil {
+		t.Fatal("expected an error for no fields")
+	}
+	if _, err := GetHeaderFieldsProof(h, headerFieldsCount); err == nil {
+		t.Fatal("expected an error for an invalid field index")
+	}
+}
+
+func TestHeaderFieldsProofMatchesSimpleTreeProof(t *testing.T) {
+	h := newTestHeader()
+	proof, err := GetHeaderFieldsProof(h, HeaderFieldTime, HeaderFieldAppHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// `getSimpleTreeProof` orders the siblings by the generalized index: 4, 6, 7, 11, 17, 26
+	expected, err := getSimpleTreeProof(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	order := []int{2, 4, 0, 3, 5, 1}
+	if len(proof.Siblings) != len(expected) {
+		t.Fatalf("unexpected number of siblings: %v", len(proof.Siblings))
+	}
+	for i, j := range order {
+		if !bytes.Equal(proof.Siblings[i][:], expected[j]) {
+			t.Fatalf("sibling mismatch: index=%v expected=%X actual=%X", i, expected[j], proof.Siblings[i])
+		}
+	}
+}
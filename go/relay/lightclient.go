@@ -1,9 +1,16 @@
 package relay
 
 import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 	"github.com/hyperledger-labs/yui-relayer/core"
 )
@@ -21,60 +28,289 @@ func (cs *ClientState) GetLatestHeight() exported.Height {
 	return cs.LatestHeight
 }
 
+// Validate checks the lengths of the verifier digests, the trusting period, the latest height and the proof specs
 func (cs *ClientState) Validate() error {
+	if len(cs.StepVerifierDigest) != 32 {
+		return fmt.Errorf("invalid step verifier digest length: %d", len(cs.StepVerifierDigest))
+	}
+	if len(cs.SkipVerifierDigest) != 32 {
+		return fmt.Errorf("invalid skip verifier digest length: %d", len(cs.SkipVerifierDigest))
+	}
+	if cs.TrustingPeriod == 0 {
+		return fmt.Errorf("trusting period cannot be zero")
+	}
+	if cs.LatestHeight.RevisionNumber != REVISION_NUMBER {
+		return fmt.Errorf("invalid revision number: expected=%d actual=%d", REVISION_NUMBER, cs.LatestHeight.RevisionNumber)
+	}
+	if cs.LatestHeight.RevisionHeight == 0 {
+		return fmt.Errorf("latest height cannot be zero")
+	}
+	if n := len(cs.ProofSpecs); n != 0 && n != 2 {
+		return fmt.Errorf("invalid proof specs length: %d", n)
+	}
+	// VerifyNonMembership only supports an IAVL store under the app hash tree
+	if len(cs.ProofSpecs) == 2 {
+		if cs.ProofSpecs[0] != uint32(IAVLSpec) {
+			return fmt.Errorf("unsupported proof spec of the IBC store: expected=%d actual=%d", IAVLSpec, cs.ProofSpecs[0])
+		}
+		if cs.ProofSpecs[1] != uint32(SimpleTree) {
+			return fmt.Errorf("unsupported proof spec of the app hash tree: expected=%d actual=%d", SimpleTree, cs.ProofSpecs[1])
+		}
+	}
 	return nil
 }
 
+// GetProofSpecs returns the specs of the IBC store and the app hash tree, which default to those of `DefaultStoreLayout`
+func (cs *ClientState) GetProofSpecs() [2]uint8 {
+	if len(cs.ProofSpecs) != 2 {
+		return DefaultStoreLayout.ProofSpecs()
+	}
+	return [2]uint8{uint8(cs.ProofSpecs[0]), uint8(cs.ProofSpecs[1])}
+}
+
+// Status returns Frozen if the client is frozen, Expired if the consensus state at the latest height is missing or older than the trusting period, and Active otherwise
 func (cs *ClientState) Status(ctx sdk.Context, clientStore sdk.KVStore, cdc codec.BinaryCodec) exported.Status {
-	panic("not implemented")
+	if cs.Frozen {
+		return exported.Frozen
+	}
+	consState, found := GetConsensusState(clientStore, cdc, cs.GetLatestHeight())
+	if !found {
+		return exported.Expired
+	}
+	if cs.isExpired(consState, ctx.BlockTime()) {
+		return exported.Expired
+	}
+	return exported.Active
+}
+
+// isExpired returns true if the trusting period of `consState` has passed at `now`
+func (cs *ClientState) isExpired(consState *ConsensusState, now time.Time) bool {
+	expiry := time.Unix(0, int64(consState.Timestamp)).Add(time.Duration(cs.TrustingPeriod))
+	return !now.Before(expiry)
 }
 
 func (cs *ClientState) ExportMetadata(clientStore sdk.KVStore) []exported.GenesisMetadata {
-	panic("not implemented")
+	return nil
 }
 
+// ZeroCustomFields returns a copy of the client state with the fields chosen by the client creator zeroed out
 func (cs *ClientState) ZeroCustomFields() exported.ClientState {
-	panic("not implemented")
+	return &ClientState{
+		StepVerifierDigest: cs.StepVerifierDigest,
+		SkipVerifierDigest: cs.SkipVerifierDigest,
+		LatestHeight:       cs.LatestHeight,
+		ProofSpecs:         cs.ProofSpecs,
+	}
 }
 
 func (cs *ClientState) GetTimestampAtHeight(ctx sdk.Context, clientStore sdk.KVStore, cdc codec.BinaryCodec, height exported.Height) (uint64, error) {
-	panic("not implemented")
+	consState, found := GetConsensusState(clientStore, cdc, height)
+	if !found {
+		return 0, fmt.Errorf("%w: height=%v", clienttypes.ErrConsensusStateNotFound, height)
+	}
+	return consState.GetTimestamp(), nil
 }
 
+// Initialize stores the client state and the initial consensus state at the latest height
 func (cs *ClientState) Initialize(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, consensusState exported.ConsensusState) error {
-	panic("not implemented")
+	consState, ok := consensusState.(*ConsensusState)
+	if !ok {
+		return fmt.Errorf("%w: expected=%T actual=%T", clienttypes.ErrInvalidConsensus, &ConsensusState{}, consensusState)
+	}
+	setClientState(clientStore, cdc, cs)
+	setConsensusState(clientStore, cdc, consState, cs.GetLatestHeight())
+	return nil
 }
 
+// VerifyMembership verifies the ABI-encoded existence proofs in the same way as `TendermintZKLightClient.verifyMembership`.
+// The delay periods are ignored as the contract does.
 func (cs *ClientState) VerifyMembership(ctx sdk.Context, clientStore sdk.KVStore, cdc codec.BinaryCodec, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proof []byte, path exported.Path, value []byte) error {
-	panic("not implemented")
+	consState, prefix, key, err := cs.getVerificationTarget(clientStore, cdc, height, path)
+	if err != nil {
+		return err
+	}
+	return VerifyEthABIExistenceProofs(cs.GetProofSpecs(), [32]byte(consState.AppHash), prefix, key, value, proof)
 }
 
+// VerifyNonMembership verifies the ABI-encoded non-membership proof in the same way as `TendermintZKLightClient.verifyNonMembership`.
+// The delay periods are ignored as the contract does.
 func (cs *ClientState) VerifyNonMembership(ctx sdk.Context, clientStore sdk.KVStore, cdc codec.BinaryCodec, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proof []byte, path exported.Path) error {
-	panic("not implemented")
+	consState, prefix, key, err := cs.getVerificationTarget(clientStore, cdc, height, path)
+	if err != nil {
+		return err
+	}
+	return VerifyEthABINonMembershipProof(cs.GetProofSpecs(), [32]byte(consState.AppHash), prefix, key, proof)
+}
+
+// getVerificationTarget returns the consensus state at `height` and the commitment prefix and the key of `path`
+func (cs *ClientState) getVerificationTarget(clientStore sdk.KVStore, cdc codec.BinaryCodec, height exported.Height, path exported.Path) (*ConsensusState, []byte, []byte, error) {
+	if height.GetRevisionNumber() != cs.LatestHeight.RevisionNumber {
+		return nil, nil, nil, fmt.Errorf("revision number mismatch: expected=%d actual=%d", cs.LatestHeight.RevisionNumber, height.GetRevisionNumber())
+	}
+	if cs.GetLatestHeight().LT(height) {
+		return nil, nil, nil, fmt.Errorf("height is greater than the latest height: height=%v latest_height=%v", height, cs.GetLatestHeight())
+	}
+	merklePath, ok := path.(commitmenttypes.MerklePath)
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("invalid path type: %T", path)
+	}
+	if len(merklePath.KeyPath) != 2 {
+		return nil, nil, nil, fmt.Errorf("invalid path length: %d", len(merklePath.KeyPath))
+	}
+	prefix, err := merklePath.GetKey(0)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	key, err := merklePath.GetKey(1)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	consState, found := GetConsensusState(clientStore, cdc, height)
+	if !found {
+		return nil, nil, nil, fmt.Errorf("%w: height=%v", clienttypes.ErrConsensusStateNotFound, height)
+	}
+	if len(consState.AppHash) != 32 {
+		return nil, nil, nil, fmt.Errorf("invalid app hash length: %d", len(consState.AppHash))
+	}
+	return consState, prefix, key, nil
 }
 
+// ZKProofVerifier verifies the zk proof of UpdateStateMessage with the public inputs
+type ZKProofVerifier func(input [3]*big.Int, proof []byte) error
+
+// zkProofVerifierKey identifies the circuits of a client by their verifier digests
+type zkProofVerifierKey struct {
+	stepVerifierDigest, skipVerifierDigest string
+}
+
+var (
+	zkProofVerifiersMu sync.RWMutex
+	// zkProofVerifiers are the verifiers used by VerifyClientMessage
+	zkProofVerifiers = make(map[zkProofVerifierKey]ZKProofVerifier)
+)
+
+// RegisterZKProofVerifier registers the verifier of the circuits whose digests are `stepVerifierDigest` and `skipVerifierDigest`.
+// A client verifies the zk proofs with the verifier registered with the digests in its client state, so the clients of different circuits can coexist.
+// A chain hosting tendermint-zk clients must register the verifiers at start-up, e.g. `RegisterZKProofVerifier(step, skip, groth16.NewVerifier(vk))`.
+// It panics if a verifier is already registered with the digests.
+func RegisterZKProofVerifier(stepVerifierDigest, skipVerifierDigest []byte, verifier ZKProofVerifier) {
+	zkProofVerifiersMu.Lock()
+	defer zkProofVerifiersMu.Unlock()
+	key := zkProofVerifierKey{string(stepVerifierDigest), string(skipVerifierDigest)}
+	if verifier == nil {
+		panic(fmt.Errorf("zk proof verifier cannot be nil"))
+	}
+	if _, ok := zkProofVerifiers[key]; ok {
+		panic(fmt.Errorf("zk proof verifier already registered: step_verifier_digest=%X skip_verifier_digest=%X", stepVerifierDigest, skipVerifierDigest))
+	}
+	zkProofVerifiers[key] = verifier
+}
+
+// getZKProofVerifier returns the verifier registered with the verifier digests of the client state
+func (cs *ClientState) getZKProofVerifier() (ZKProofVerifier, error) {
+	zkProofVerifiersMu.RLock()
+	defer zkProofVerifiersMu.RUnlock()
+	verifier, ok := zkProofVerifiers[zkProofVerifierKey{string(cs.StepVerifierDigest), string(cs.SkipVerifierDigest)}]
+	if !ok {
+		return nil, fmt.Errorf("zk proof verifier is not registered: step_verifier_digest=%X skip_verifier_digest=%X", cs.StepVerifierDigest, cs.SkipVerifierDigest)
+	}
+	return verifier, nil
+}
+
+// VerifyClientMessage verifies UpdateStateMessage in the same way as `TendermintZKLightClient.updateState`.
+// Both messages of Misbehaviour must also pass the verification against their trusted consensus states.
 func (cs *ClientState) VerifyClientMessage(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientMsg exported.ClientMessage) error {
-	panic("not implemented")
+	switch msg := clientMsg.(type) {
+	case *UpdateStateMessage:
+		return cs.verifyUpdateState(ctx, cdc, clientStore, msg)
+	case *Misbehaviour:
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		if err := cs.verifyUpdateState(ctx, cdc, clientStore, msg.UpdateState1); err != nil {
+			return fmt.Errorf("invalid update state 1: %w", err)
+		}
+		if err := cs.verifyUpdateState(ctx, cdc, clientStore, msg.UpdateState2); err != nil {
+			return fmt.Errorf("invalid update state 2: %w", err)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: unexpected client message type: %T", clienttypes.ErrInvalidClientType, clientMsg)
+	}
+}
+
+func (cs *ClientState) verifyUpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, msg *UpdateStateMessage) error {
+	verifyZKProof, err := cs.getZKProofVerifier()
+	if err != nil {
+		return err
+	}
+	trusted, found := GetConsensusState(clientStore, cdc, clienttypes.NewHeight(cs.LatestHeight.RevisionNumber, msg.TrustedHeight))
+	if !found {
+		return fmt.Errorf("%w: trusted_height=%d", clienttypes.ErrConsensusStateNotFound, msg.TrustedHeight)
+	}
+	v := UpdateStateValidator{
+		StepVerifierDigest: cs.StepVerifierDigest,
+		SkipVerifierDigest: cs.SkipVerifierDigest,
+		TrustingPeriod:     time.Duration(cs.TrustingPeriod),
+		VerifyZKProof:      verifyZKProof,
+	}
+	return v.Validate(msg, trusted, ctx.BlockTime())
 }
 
+// CheckForMisbehaviour returns true if `clientMsg` proves a block that conflicts with the stored consensus state or is a Misbehaviour with two conflicting blocks at the same height.
+// The message is assumed to have been verified by VerifyClientMessage.
 func (cs *ClientState) CheckForMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientMsg exported.ClientMessage) bool {
-	panic("not implemented")
+	switch msg := clientMsg.(type) {
+	case *UpdateStateMessage:
+		existingConsState, found := GetConsensusState(clientStore, cdc, msg.GetHeight())
+		if !found {
+			return false
+		}
+		return !bytes.Equal(existingConsState.BlockHash, msg.UntrustedBlockHash)
+	case *Misbehaviour:
+		return msg.ValidateBasic() == nil
+	}
+	return false
 }
 
+// UpdateStateOnMisbehaviour freezes the client
 func (cs *ClientState) UpdateStateOnMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientMsg exported.ClientMessage) {
-	panic("not implemented")
+	newClientState := *cs
+	newClientState.Frozen = true
+	setClientState(clientStore, cdc, &newClientState)
 }
 
+// UpdateState stores the consensus state of the verified UpdateStateMessage and advances the latest height.
+// A message for the height whose consensus state already exists is a no-op.
 func (cs *ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientMsg exported.ClientMessage) []exported.Height {
-	panic("not implemented")
+	msg, ok := clientMsg.(*UpdateStateMessage)
+	if !ok {
+		panic(fmt.Errorf("unexpected client message type: %T", clientMsg))
+	}
+	height := clienttypes.NewHeight(cs.LatestHeight.RevisionNumber, msg.UntrustedHeight)
+	if _, found := GetConsensusState(clientStore, cdc, height); found {
+		return []exported.Height{height}
+	}
+	setConsensusState(clientStore, cdc, &ConsensusState{
+		BlockHash: msg.UntrustedBlockHash,
+		AppHash:   msg.AppHash,
+		Timestamp: msg.Timestamp,
+	}, height)
+	if height.GT(cs.LatestHeight) {
+		cs.LatestHeight = height
+	}
+	setClientState(clientStore, cdc, cs)
+	return []exported.Height{height}
 }
 
+// CheckSubstituteAndUpdateState is not supported as `TendermintZKLightClient` doesn't support client recovery
 func (cs *ClientState) CheckSubstituteAndUpdateState(ctx sdk.Context, cdc codec.BinaryCodec, subjectClientStore, substituteClientStore sdk.KVStore, substituteClient exported.ClientState) error {
-	panic("not implemented")
+	return fmt.Errorf("%w: substitute is not supported", clienttypes.ErrUpdateClientFailed)
 }
 
+// VerifyUpgradeAndUpdateState is not supported as `TendermintZKLightClient` doesn't support client upgrades
 func (cs *ClientState) VerifyUpgradeAndUpdateState(ctx sdk.Context, cdc codec.BinaryCodec, store sdk.KVStore, newClient exported.ClientState, newConsState exported.ConsensusState, proofUpgradeClient, proofUpgradeConsState []byte) error {
-	panic("not implemented")
+	return fmt.Errorf("%w: upgrade is not supported", clienttypes.ErrInvalidUpgradeClient)
 }
 
 var _ exported.ConsensusState = (*ConsensusState)(nil)
@@ -87,7 +323,17 @@ func (cs *ConsensusState) GetTimestamp() uint64 {
 	return cs.Timestamp
 }
 
+// ValidateBasic checks the lengths of the hashes and the timestamp
 func (cs *ConsensusState) ValidateBasic() error {
+	if len(cs.BlockHash) != 32 {
+		return fmt.Errorf("invalid block hash length: %d", len(cs.BlockHash))
+	}
+	if len(cs.AppHash) != 32 {
+		return fmt.Errorf("invalid app hash length: %d", len(cs.AppHash))
+	}
+	if cs.Timestamp == 0 {
+		return fmt.Errorf("timestamp cannot be zero")
+	}
 	return nil
 }
 
@@ -100,7 +346,42 @@ func (UpdateStateMessage) ClientType() string {
 	return TENDERMINT_ZK_CLIENT_TYPE
 }
 
-func (UpdateStateMessage) ValidateBasic() error {
+// ValidateBasic checks the heights and the lengths of the fields which `TendermintZKLightClient.updateState` expects
+func (m UpdateStateMessage) ValidateBasic() error {
+	if m.TrustedHeight == 0 {
+		return fmt.Errorf("trusted height cannot be zero")
+	}
+	if m.TrustedHeight >= m.UntrustedHeight {
+		return fmt.Errorf("trusted height must be less than untrusted height: trusted_height=%d untrusted_height=%d", m.TrustedHeight, m.UntrustedHeight)
+	}
+	if len(m.UntrustedBlockHash) != 32 {
+		return fmt.Errorf("invalid untrusted block hash length: %d", len(m.UntrustedBlockHash))
+	}
+	if len(m.AppHash) != 32 {
+		return fmt.Errorf("invalid app hash length: %d", len(m.AppHash))
+	}
+	if m.Timestamp == 0 {
+		return fmt.Errorf("timestamp cannot be zero")
+	}
+	if len(m.SimpleTreeProof) != 6 {
+		return fmt.Errorf("invalid simple tree proof length: %d", len(m.SimpleTreeProof))
+	}
+	for i, p := range m.SimpleTreeProof {
+		if len(p) != 32 {
+			return fmt.Errorf("invalid simple tree proof[%d] length: %d", i, len(p))
+		}
+	}
+	if len(m.Input) != 3 {
+		return fmt.Errorf("invalid input length: %d", len(m.Input))
+	}
+	for i, in := range m.Input {
+		if len(in) > 32 {
+			return fmt.Errorf("invalid input[%d] length: %d", i, len(in))
+		}
+	}
+	if len(m.ZkProof) == 0 {
+		return fmt.Errorf("zk proof cannot be empty")
+	}
 	return nil
 }
 
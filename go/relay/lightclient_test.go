@@ -0,0 +1,318 @@
+package relay
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/datachainlab/tendermint-zk-ibc/go/inputs"
+)
+
+func newTestClientState() *ClientState {
+	return &ClientState{
+		StepVerifierDigest: make([]byte, 32),
+		SkipVerifierDigest: make([]byte, 32),
+		TrustingPeriod:     uint64(time.Hour),
+		LatestHeight:       clienttypes.NewHeight(REVISION_NUMBER, 10),
+	}
+}
+
+func TestClientStateValidate(t *testing.T) {
+	cases := []struct {
+		name   string
+		modify func(cs *ClientState)
+		ok     bool
+	}{
+		{"valid", func(cs *ClientState) {}, true},
+		{"short step digest", func(cs *ClientState) { cs.StepVerifierDigest = make([]byte, 31) }, false},
+		{"nil skip digest", func(cs *ClientState) { cs.SkipVerifierDigest = nil }, false},
+		{"zero trusting period", func(cs *ClientState) { cs.TrustingPeriod = 0 }, false},
+		{"zero latest height", func(cs *ClientState) { cs.LatestHeight = clienttypes.ZeroHeight() }, false},
+		{"other revision", func(cs *ClientState) { cs.LatestHeight = clienttypes.NewHeight(1, 10) }, false},
+		{"proof specs", func(cs *ClientState) { cs.ProofSpecs = []uint32{uint32(IAVLSpec), uint32(SimpleTree)} }, true},
+		{"single proof spec", func(cs *ClientState) { cs.ProofSpecs = []uint32{uint32(IAVLSpec)} }, false},
+		{"unknown proof spec", func(cs *ClientState) { cs.ProofSpecs = []uint32{4, uint32(SimpleTree)} }, false},
+		{"smt store", func(cs *ClientState) { cs.ProofSpecs = []uint32{uint32(SMTSpec), uint32(SimpleTree)} }, false},
+		{"swapped proof specs", func(cs *ClientState) { cs.ProofSpecs = []uint32{uint32(SimpleTree), uint32(IAVLSpec)} }, false},
+		{"iavl app hash tree", func(cs *ClientState) { cs.ProofSpecs = []uint32{uint32(IAVLSpec), uint32(IAVLSpec)} }, false},
+	}
+	for _, c := range cases {
+		cs := newTestClientState()
+		c.modify(cs)
+		if err := cs.Validate(); c.ok != (err == nil) {
+			t.Errorf("%s: unexpected result: %v", c.name, err)
+		}
+	}
+}
+
+func TestClientStateStatus(t *testing.T) {
+	cdc := newTestCodec()
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	cs := newTestClientState()
+	now := time.Unix(1700000000, 0)
+
+	ctx := sdk.Context{}.WithBlockTime(now)
+	if status := cs.Status(ctx, store, cdc); status != exported.Expired {
+		t.Errorf("missing consensus state: unexpected status: %v", status)
+	}
+	setConsensusState(store, cdc, &ConsensusState{Timestamp: uint64(now.UnixNano())}, cs.LatestHeight)
+
+	cases := []struct {
+		name   string
+		frozen bool
+		now    time.Time
+		status exported.Status
+	}{
+		{"active", false, now.Add(time.Hour - 1), exported.Active},
+		{"expired", false, now.Add(time.Hour), exported.Expired},
+		{"frozen", true, now, exported.Frozen},
+	}
+	for _, c := range cases {
+		cs := newTestClientState()
+		cs.Frozen = c.frozen
+		if status := cs.Status(sdk.Context{}.WithBlockTime(c.now), store, cdc); status != c.status {
+			t.Errorf("%s: unexpected status: %v", c.name, status)
+		}
+	}
+}
+
+func TestClientStateZeroCustomFields(t *testing.T) {
+	cs := newTestClientState()
+	cs.Frozen = true
+	zeroed := cs.ZeroCustomFields().(*ClientState)
+	if zeroed.TrustingPeriod != 0 || zeroed.Frozen {
+		t.Errorf("custom fields must be zeroed: %v", zeroed)
+	}
+	if !zeroed.LatestHeight.EQ(cs.LatestHeight) || len(zeroed.StepVerifierDigest) != 32 || len(zeroed.SkipVerifierDigest) != 32 {
+		t.Errorf("chain specific fields must be kept: %v", zeroed)
+	}
+}
+
+func TestClientStateGetTimestampAtHeight(t *testing.T) {
+	cdc := newTestCodec()
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	cs := newTestClientState()
+	setConsensusState(store, cdc, &ConsensusState{Timestamp: 100}, cs.LatestHeight)
+
+	if ts, err := cs.GetTimestampAtHeight(sdk.Context{}, store, cdc, cs.LatestHeight); err != nil || ts != 100 {
+		t.Errorf("unexpected result: %v %v", ts, err)
+	}
+	if _, err := cs.GetTimestampAtHeight(sdk.Context{}, store, cdc, clienttypes.NewHeight(REVISION_NUMBER, 9)); !errors.Is(err, clienttypes.ErrConsensusStateNotFound) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestClientStateVerifyMembership(t *testing.T) {
+	cdc := newTestCodec()
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	cs := newTestClientState()
+	ctx := sdk.Context{}
+
+	path := "connections/connection-0"
+	root, merkleProof := newTestMerkleProof(t, testIBCStore, path)
+	proof, err := verifyAndConvertToExistenceProof(DefaultStoreLayout, root, path, testIBCStore[path], merkleProof)
+	if err != nil {
+		t.Fatal(err)
+	}
+	setConsensusState(store, cdc, &ConsensusState{AppHash: root[:]}, cs.LatestHeight)
+
+	cases := []struct {
+		name   string
+		height exported.Height
+		path   exported.Path
+		value  []byte
+		ok     bool
+	}{
+		{"valid", cs.LatestHeight, commitmenttypes.NewMerklePath(DefaultIBCStoreKey, path), testIBCStore[path], true},
+		{"wrong value", cs.LatestHeight, commitmenttypes.NewMerklePath(DefaultIBCStoreKey, path), []byte("other"), false},
+		{"wrong path", cs.LatestHeight, commitmenttypes.NewMerklePath(DefaultIBCStoreKey, "connections/connection-1"), testIBCStore[path], false},
+		{"wrong prefix", cs.LatestHeight, commitmenttypes.NewMerklePath("bank", path), testIBCStore[path], false},
+		{"missing consensus state", clienttypes.NewHeight(REVISION_NUMBER, 9), commitmenttypes.NewMerklePath(DefaultIBCStoreKey, path), testIBCStore[path], false},
+		{"future height", clienttypes.NewHeight(REVISION_NUMBER, 11), commitmenttypes.NewMerklePath(DefaultIBCStoreKey, path), testIBCStore[path], false},
+		{"invalid path length", cs.LatestHeight, commitmenttypes.NewMerklePath(path), testIBCStore[path], false},
+	}
+	for _, c := range cases {
+		if err := cs.VerifyMembership(ctx, store, cdc, c.height, 0, 0, proof, c.path, c.value); c.ok != (err == nil) {
+			t.Errorf("%s: unexpected result: %v", c.name, err)
+		}
+	}
+
+	// the proof must have the specs of the client state
+	cs.ProofSpecs = []uint32{uint32(SMTSpec), uint32(SimpleTree)}
+	if err := cs.VerifyMembership(ctx, store, cdc, cs.LatestHeight, 0, 0, proof, commitmenttypes.NewMerklePath(DefaultIBCStoreKey, path), testIBCStore[path]); err == nil {
+		t.Error("expected an error for the proof specs mismatch")
+	}
+}
+
+func TestClientStateVerifyNonMembership(t *testing.T) {
+	cdc := newTestCodec()
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	cs := newTestClientState()
+	ctx := sdk.Context{}
+
+	path := "commitments/ports/transfer/channels/channel-0/sequences/2"
+	root, merkleProof := newTestMerkleProof(t, testIBCStore, path)
+	proof, err := verifyAndConvertToNonExistenceProof(DefaultStoreLayout, root, path, merkleProof)
+	if err != nil {
+		t.Fatal(err)
+	}
+	setConsensusState(store, cdc, &ConsensusState{AppHash: root[:]}, cs.LatestHeight)
+
+	cases := []struct {
+		name string
+		path exported.Path
+		ok   bool
+	}{
+		{"valid", commitmenttypes.NewMerklePath(DefaultIBCStoreKey, path), true},
+		{"wrong path", commitmenttypes.NewMerklePath(DefaultIBCStoreKey, "commitments/ports/transfer/channels/channel-0/sequences/3"), false},
+		{"wrong prefix", commitmenttypes.NewMerklePath("bank", path), false},
+	}
+	for _, c := range cases {
+		if err := cs.VerifyNonMembership(ctx, store, cdc, cs.LatestHeight, 0, 0, proof, c.path); c.ok != (err == nil) {
+			t.Errorf("%s: unexpected result: %v", c.name, err)
+		}
+	}
+
+	// the proof must be rejected against another app hash
+	setConsensusState(store, cdc, &ConsensusState{AppHash: make([]byte, 32)}, cs.LatestHeight)
+	if err := cs.VerifyNonMembership(ctx, store, cdc, cs.LatestHeight, 0, 0, proof, commitmenttypes.NewMerklePath(DefaultIBCStoreKey, path)); err == nil {
+		t.Error("expected an error for a wrong app hash")
+	}
+}
+
+func TestClientStateVerifyAndUpdateState(t *testing.T) {
+	cdc := newTestCodec()
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	h := newTestHeader()
+	cs := newTestClientState()
+	cs.StepVerifierDigest = bytes.Repeat([]byte{0x01}, 32)
+	cs.SkipVerifierDigest = bytes.Repeat([]byte{0x02}, 32)
+	cs.LatestHeight = clienttypes.NewHeight(REVISION_NUMBER, uint64(h.Height-1))
+	trusted := &ConsensusState{
+		BlockHash: bytes.Repeat([]byte{0xaa}, 32),
+		AppHash:   bytes.Repeat([]byte{0xbb}, 32),
+		Timestamp: uint64(h.Time.Add(-time.Minute).UnixNano()),
+	}
+	if err := cs.Initialize(sdk.Context{}, cdc, store, trusted); err != nil {
+		t.Fatal(err)
+	}
+	ctx := sdk.Context{}.WithBlockTime(h.Time.Add(time.Minute))
+
+	simpleTreeProof, err := getSimpleTreeProof(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	input := inputs.Compute(cs.StepVerifierDigest, cs.SkipVerifierDigest, uint64(h.Height-1), trusted.BlockHash, uint64(h.Height), h.Hash())
+	msg := &UpdateStateMessage{
+		TrustedHeight:      uint64(h.Height - 1),
+		UntrustedHeight:    uint64(h.Height),
+		UntrustedBlockHash: h.Hash(),
+		Timestamp:          uint64(h.Time.UnixNano()),
+		AppHash:            h.AppHash,
+		SimpleTreeProof:    simpleTreeProof[:],
+		Input:              [][]byte{input[0][:], input[1][:], input[2][:]},
+		ZkProof:            []byte("valid"),
+	}
+
+	if err := cs.VerifyClientMessage(ctx, cdc, store, msg); err == nil {
+		t.Fatal("expected an error without the zk proof verifier")
+	}
+	registerTestZKProofVerifier(t, cs.StepVerifierDigest, cs.SkipVerifierDigest, func(input [3]*big.Int, proof []byte) error {
+		if string(proof) != "valid" {
+			return fmt.Errorf("invalid proof: %s", proof)
+		}
+		return nil
+	})
+	// the verifier of other circuits is not used
+	registerTestZKProofVerifier(t, cs.SkipVerifierDigest, cs.StepVerifierDigest, func(input [3]*big.Int, proof []byte) error {
+		return nil
+	})
+
+	if err := cs.VerifyClientMessage(ctx, cdc, store, msg); err != nil {
+		t.Fatal(err)
+	}
+	invalid := *msg
+	invalid.ZkProof = []byte("invalid")
+	if err := cs.VerifyClientMessage(ctx, cdc, store, &invalid); err == nil {
+		t.Error("expected an error for an invalid zk proof")
+	}
+	if err := cs.VerifyClientMessage(ctx, cdc, store, NewMisbehaviour(msg, newTestUpdateStateMessage(uint64(h.Height-1), uint64(h.Height), 1))); err == nil {
+		t.Error("expected an error for a misbehaviour with an unverifiable message")
+	}
+
+	heights := cs.UpdateState(ctx, cdc, store, msg)
+	if len(heights) != 1 || !heights[0].EQ(msg.GetHeight()) {
+		t.Fatalf("unexpected heights: %v", heights)
+	}
+	if !cs.LatestHeight.EQ(msg.GetHeight()) {
+		t.Errorf("unexpected latest height: %v", cs.LatestHeight)
+	}
+	consState, found := GetConsensusState(store, cdc, msg.GetHeight())
+	if !found || !bytes.Equal(consState.BlockHash, h.Hash()) || !bytes.Equal(consState.AppHash, h.AppHash) || consState.Timestamp != msg.Timestamp {
+		t.Fatalf("unexpected consensus state: %v", consState)
+	}
+	if status := cs.Status(ctx, store, cdc); status != exported.Active {
+		t.Errorf("unexpected status: %v", status)
+	}
+
+	// the existing consensus state must not be overwritten
+	conflicting := *msg
+	conflicting.AppHash = trusted.AppHash
+	cs.UpdateState(ctx, cdc, store, &conflicting)
+	if consState, _ := GetConsensusState(store, cdc, msg.GetHeight()); !bytes.Equal(consState.AppHash, h.AppHash) {
+		t.Errorf("the consensus state must not be overwritten: %v", consState)
+	}
+}
+
+// registerTestZKProofVerifier registers the verifier until the end of the test
+func registerTestZKProofVerifier(t *testing.T, stepVerifierDigest, skipVerifierDigest []byte, verifier ZKProofVerifier) {
+	RegisterZKProofVerifier(stepVerifierDigest, skipVerifierDigest, verifier)
+	t.Cleanup(func() {
+		zkProofVerifiersMu.Lock()
+		defer zkProofVerifiersMu.Unlock()
+		delete(zkProofVerifiers, zkProofVerifierKey{string(stepVerifierDigest), string(skipVerifierDigest)})
+	})
+}
+
+func TestRegisterZKProofVerifier(t *testing.T) {
+	step, skip := bytes.Repeat([]byte{0x03}, 32), bytes.Repeat([]byte{0x04}, 32)
+	cs := &ClientState{StepVerifierDigest: step, SkipVerifierDigest: skip}
+	if _, err := cs.getZKProofVerifier(); err == nil {
+		t.Fatal("expected an error for the unregistered digests")
+	}
+	registerTestZKProofVerifier(t, step, skip, func(input [3]*big.Int, proof []byte) error {
+		return nil
+	})
+	if _, err := cs.getZKProofVerifier(); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name     string
+		step     []byte
+		skip     []byte
+		verifier ZKProofVerifier
+	}{
+		{"already registered", step, skip, func(input [3]*big.Int, proof []byte) error { return nil }},
+		{"nil", skip, step, nil},
+	}
+	for _, c := range cases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected a panic", c.name)
+				}
+			}()
+			RegisterZKProofVerifier(c.step, c.skip, c.verifier)
+		}()
+	}
+}
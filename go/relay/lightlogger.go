@@ -0,0 +1,33 @@
+package relay
+
+import (
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/hyperledger-labs/yui-relayer/log"
+)
+
+// lightLogger routes the logs of the cometbft light client into the relayer logger
+type lightLogger struct {
+	logger *log.RelayLogger
+}
+
+var _ cmtlog.Logger = (*lightLogger)(nil)
+
+func newLightLogger() cmtlog.Logger {
+	return lightLogger{logger: log.GetLogger().WithModule("tendermintzk.light")}
+}
+
+func (l lightLogger) Debug(msg string, keyvals ...interface{}) {
+	l.logger.Debug(msg, keyvals...)
+}
+
+func (l lightLogger) Info(msg string, keyvals ...interface{}) {
+	l.logger.Info(msg, keyvals...)
+}
+
+func (l lightLogger) Error(msg string, keyvals ...interface{}) {
+	l.logger.Logger.Error(msg, keyvals...)
+}
+
+func (l lightLogger) With(keyvals ...interface{}) cmtlog.Logger {
+	return lightLogger{logger: &log.RelayLogger{Logger: l.logger.With(keyvals...)}}
+}
@@ -0,0 +1,47 @@
+package relay
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+var _ exported.ClientMessage = (*Misbehaviour)(nil)
+
+// NewMisbehaviour creates a new Misbehaviour from two conflicting update state messages
+func NewMisbehaviour(updateState1, updateState2 *UpdateStateMessage) *Misbehaviour {
+	return &Misbehaviour{UpdateState1: updateState1, UpdateState2: updateState2}
+}
+
+func (Misbehaviour) ClientType() string {
+	return TENDERMINT_ZK_CLIENT_TYPE
+}
+
+// GetHeight returns the height at which the two update state messages conflict
+func (m Misbehaviour) GetHeight() exported.Height {
+	return m.UpdateState1.GetHeight()
+}
+
+// ValidateBasic checks that the two update state messages prove different blocks at the same height
+func (m Misbehaviour) ValidateBasic() error {
+	if m.UpdateState1 == nil {
+		return fmt.Errorf("misbehaviour update state 1 cannot be nil")
+	}
+	if m.UpdateState2 == nil {
+		return fmt.Errorf("misbehaviour update state 2 cannot be nil")
+	}
+	if err := m.UpdateState1.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid update state 1: %w", err)
+	}
+	if err := m.UpdateState2.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid update state 2: %w", err)
+	}
+	if m.UpdateState1.UntrustedHeight != m.UpdateState2.UntrustedHeight {
+		return fmt.Errorf("misbehaviour heights must be equal: %d != %d", m.UpdateState1.UntrustedHeight, m.UpdateState2.UntrustedHeight)
+	}
+	if bytes.Equal(m.UpdateState1.UntrustedBlockHash, m.UpdateState2.UntrustedBlockHash) {
+		return fmt.Errorf("misbehaviour block hashes must be different: %X", m.UpdateState1.UntrustedBlockHash)
+	}
+	return nil
+}
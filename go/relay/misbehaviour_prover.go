@@ -0,0 +1,179 @@
+package relay
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	dbs "github.com/cometbft/cometbft/light/store/db"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	tmtypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/hyperledger-labs/yui-relayer/core"
+)
+
+// ReportDivergence submits Misbehaviour to the counterparty client if a witness serves a header at `height` that differs from the one of the primary.
+// The local light client cannot verify either header, so both are proven from the highest height where the counterparty client and the local light client agree.
+func (pr *Prover) ReportDivergence(counterparty core.FinalityAwareChain, ctx core.QueryContext, height ibcexported.Height) error {
+	trustedHeight, trustedConsensusState, err := pr.commonTrustedHeight(counterparty, ctx, height)
+	if err != nil {
+		return err
+	}
+	log := getLogger()
+	for _, addr := range pr.config.WitnessAddrs {
+		witness, err := rpchttp.New(addr, "/websocket")
+		if err != nil {
+			return fmt.Errorf("failed to create a witness client: addr=%v %w", addr, err)
+		}
+		misbehaviour, err := pr.BuildMisbehaviour(witness, trustedHeight, height.GetRevisionHeight(), trustedConsensusState)
+		if err != nil {
+			log.Error("failed to build misbehaviour from the witness", err, "witness", addr, "height", height.String())
+			continue
+		} else if misbehaviour == nil {
+			continue
+		}
+		return pr.SubmitMisbehaviour(counterparty, misbehaviour)
+	}
+	return fmt.Errorf("no witness serves a provable header conflicting with the primary: height=%v", height)
+}
+
+// BuildMisbehaviour returns a Misbehaviour if `witness` serves a header at `height` that differs from the one of the primary, otherwise nil.
+// The witness header is proven by a prover reading `witness`, and both messages must be accepted by the client whose consensus state at `trustedHeight` is `trusted`.
+// A remote prover reads its own node, so the proof of the witness header is rejected as the inputs don't match.
+func (pr *Prover) BuildMisbehaviour(witness rpcclient.Client, trustedHeight, height uint64, trusted *ConsensusState) (*Misbehaviour, error) {
+	witnessProver := pr.zkProverClient
+	witnessProver.TMClient = witness
+	validator := NewUpdateStateValidator(pr.config, pr.zkProverClient.ZKProofVerifier())
+	return buildMisbehaviour(pr.zkProverClient, witnessProver, trustedHeight, height, func(msg *UpdateStateMessage) error {
+		return validator.Validate(msg, trusted, time.Now())
+	})
+}
+
+// buildMisbehaviour proves the headers at `height` served by the clients of `primary` and `witness` and returns them as Misbehaviour if they differ
+func buildMisbehaviour(primary, witness ZKProverClient, trustedHeight, height uint64, validate func(msg *UpdateStateMessage) error) (*Misbehaviour, error) {
+	primaryHeader, err := getHeader(primary.TMClient, height)
+	if err != nil {
+		return nil, fmt.Errorf("primary: %w", err)
+	}
+	witnessHeader, err := getHeader(witness.TMClient, height)
+	if err != nil {
+		return nil, fmt.Errorf("witness: %w", err)
+	}
+	if bytes.Equal(primaryHeader.Hash(), witnessHeader.Hash()) {
+		return nil, nil
+	}
+	msg1, err := newUpdateStateMessage(trustedHeight, primaryHeader, primary.Prove)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prove the primary header@%d: %w", height, err)
+	} else if err := validate(msg1); err != nil {
+		return nil, fmt.Errorf("invalid update state message of the primary header@%d: %w", height, err)
+	}
+	msg2, err := newUpdateStateMessage(trustedHeight, witnessHeader, witness.Prove)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prove the witness header@%d: %w", height, err)
+	} else if err := validate(msg2); err != nil {
+		return nil, fmt.Errorf("invalid update state message of the witness header@%d: %w", height, err)
+	}
+	misbehaviour := NewMisbehaviour(msg1, msg2)
+	if err := misbehaviour.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return misbehaviour, nil
+}
+
+// getHeader returns the header at `height` served by `client`
+func getHeader(client rpcclient.Client, height uint64) (*tmtypes.Header, error) {
+	res, err := client.Header(context.TODO(), int64Ptr(height))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the header@%d: %w", height, err)
+	}
+	if res.Header == nil || res.Header.Height != int64(height) {
+		return nil, fmt.Errorf("unexpected header: expected_height=%d", height)
+	}
+	return res.Header, nil
+}
+
+// SubmitMisbehaviour submits `misbehaviour` to the client on the counterparty chain, which freezes the client
+func (pr *Prover) SubmitMisbehaviour(counterparty core.Chain, misbehaviour *Misbehaviour) error {
+	signer, err := counterparty.GetAddress()
+	if err != nil {
+		return err
+	}
+	msg, err := clienttypes.NewMsgUpdateClient(counterparty.Path().ClientID, misbehaviour, signer.String())
+	if err != nil {
+		return err
+	}
+	if _, err := counterparty.SendMsgs([]sdk.Msg{msg}); err != nil {
+		return fmt.Errorf("failed to submit misbehaviour: %w", err)
+	}
+	getLogger().Info("submitted misbehaviour", "client_id", counterparty.Path().ClientID, "height", misbehaviour.GetHeight().String())
+	return nil
+}
+
+// ReportConflictingConsensusState submits the UpdateStateMessage of the block verified by the local light client at `height` to the counterparty client that has a conflicting consensus state at the height.
+// The client freezes itself on a valid message that proves another block at a height with a consensus state.
+func (pr *Prover) ReportConflictingConsensusState(counterparty core.FinalityAwareChain, ctx core.QueryContext, height ibcexported.Height) error {
+	trustedHeight, trustedConsensusState, err := pr.commonTrustedHeight(counterparty, ctx, height)
+	if err != nil {
+		return err
+	}
+	msg, err := pr.BuildUpdateStateMessage(trustedHeight, height.GetRevisionHeight())
+	if err != nil {
+		return err
+	}
+	if err := NewUpdateStateValidator(pr.config, pr.zkProverClient.ZKProofVerifier()).Validate(msg, trustedConsensusState, time.Now()); err != nil {
+		return fmt.Errorf("invalid update state message: %w", err)
+	}
+	signer, err := counterparty.GetAddress()
+	if err != nil {
+		return err
+	}
+	updateMsg, err := clienttypes.NewMsgUpdateClient(counterparty.Path().ClientID, msg, signer.String())
+	if err != nil {
+		return err
+	}
+	if _, err := counterparty.SendMsgs([]sdk.Msg{updateMsg}); err != nil {
+		return fmt.Errorf("failed to submit the conflicting update state message: %w", err)
+	}
+	getLogger().Info("submitted the update state message conflicting with the counterparty consensus state", "client_id", counterparty.Path().ClientID, "trusted_height", trustedHeight, "height", height.String())
+	return nil
+}
+
+// commonTrustedHeight returns the highest height below `height` at which the counterparty client has the consensus state of the block in the local light client store
+func (pr *Prover) commonTrustedHeight(counterparty core.ICS02Querier, ctx core.QueryContext, height ibcexported.Height) (uint64, *ConsensusState, error) {
+	db, df, err := pr.NewLightDB()
+	if err != nil {
+		return 0, nil, err
+	}
+	defer df()
+	return findCommonTrustedHeight(int64(height.GetRevisionHeight()), pr.config.GetMisbehaviourLookback(), dbs.New(db, "").LightBlockBefore, func(h uint64) (*ConsensusState, error) {
+		return pr.QueryCounterpartyConsensusState(counterparty, ctx, clienttypes.NewHeight(height.GetRevisionNumber(), h))
+	})
+}
+
+// DefaultMisbehaviourLookback is the number of light blocks checked by findCommonTrustedHeight by default
+const DefaultMisbehaviourLookback = 100
+
+// findCommonTrustedHeight walks down at most `lookback` light blocks before `height` and returns the first one whose block the counterparty client has as its consensus state.
+// The heights without a consensus state are skipped because the client is not updated at every height.
+func findCommonTrustedHeight(height int64, lookback int, lightBlockBefore func(height int64) (*tmtypes.LightBlock, error), consensusState func(height uint64) (*ConsensusState, error)) (uint64, *ConsensusState, error) {
+	for i := 0; i < lookback; i++ {
+		lb, err := lightBlockBefore(height)
+		if err != nil {
+			return 0, nil, fmt.Errorf("%w below %d: %v", ErrNoCommonTrustedHeight, height, err)
+		}
+		height = lb.Height
+		cs, err := consensusState(uint64(height))
+		if err != nil {
+			continue
+		}
+		if bytes.Equal(cs.BlockHash, lb.Hash()) {
+			return uint64(height), cs, nil
+		}
+	}
+	return 0, nil, fmt.Errorf("%w in the %d light blocks down to %d: increase misbehaviour_lookback to check more blocks", ErrNoCommonTrustedHeight, lookback, height)
+}
@@ -0,0 +1,193 @@
+package relay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cometbfttypes "github.com/cometbft/cometbft/types"
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp/groth16"
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp/mock"
+)
+
+// testWitnessClient serves the headers of testHeaderClient, but the ones from `forkHeight` belong to a fork
+type testWitnessClient struct {
+	testHeaderClient
+	forkHeight  int64
+	heightDelta int64
+	err         error
+}
+
+func (c testWitnessClient) Header(ctx context.Context, height *int64) (*ctypes.ResultHeader, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	res, err := c.testHeaderClient.Header(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	if c.forkHeight != 0 && *height >= c.forkHeight {
+		res.Header.AppHash = tmhash.Sum([]byte("forked_app"))
+	}
+	res.Header.Height += c.heightDelta
+	return res, nil
+}
+
+func TestFindCommonTrustedHeight(t *testing.T) {
+	// the local light client store has the blocks at 10, 20, 30 and 40
+	lightBlocks := make(map[int64]*cometbfttypes.LightBlock)
+	for _, h := range []int64{10, 20, 30, 40} {
+		header := newTestHeader()
+		header.Height = h
+		lightBlocks[h] = &cometbfttypes.LightBlock{SignedHeader: &cometbfttypes.SignedHeader{Header: header}}
+	}
+	lightBlockBefore := func(height int64) (*cometbfttypes.LightBlock, error) {
+		for h := height - 1; h > 0; h-- {
+			if lb, ok := lightBlocks[h]; ok {
+				return lb, nil
+			}
+		}
+		return nil, fmt.Errorf("light block not found before %d", height)
+	}
+	consensusStateOf := func(h int64) *ConsensusState {
+		return &ConsensusState{BlockHash: lightBlocks[h].Hash()}
+	}
+	forked := &ConsensusState{BlockHash: make([]byte, 32)}
+
+	cases := []struct {
+		name            string
+		lookback        int
+		consensusStates map[uint64]*ConsensusState
+		expected        uint64
+	}{
+		{"latest", DefaultMisbehaviourLookback, map[uint64]*ConsensusState{30: consensusStateOf(30)}, 30},
+		{"skip the heights without consensus state", DefaultMisbehaviourLookback, map[uint64]*ConsensusState{10: consensusStateOf(10)}, 10},
+		{"skip the forked consensus states", DefaultMisbehaviourLookback, map[uint64]*ConsensusState{30: forked, 20: consensusStateOf(20)}, 20},
+		{"no common height", DefaultMisbehaviourLookback, map[uint64]*ConsensusState{30: forked, 20: forked, 10: forked}, 0},
+		{"no consensus state", DefaultMisbehaviourLookback, map[uint64]*ConsensusState{}, 0},
+		{"within the lookback", 3, map[uint64]*ConsensusState{10: consensusStateOf(10)}, 10},
+		{"beyond the lookback", 2, map[uint64]*ConsensusState{10: consensusStateOf(10)}, 0},
+	}
+	for _, c := range cases {
+		height, cs, err := findCommonTrustedHeight(40, c.lookback, lightBlockBefore, func(h uint64) (*ConsensusState, error) {
+			if cs, ok := c.consensusStates[h]; ok {
+				return cs, nil
+			}
+			return nil, fmt.Errorf("consensus state not found: height=%d", h)
+		})
+		if c.expected == 0 {
+			if !errors.Is(err, ErrNoCommonTrustedHeight) {
+				t.Errorf("%s: unexpected result: height=%d err=%v", c.name, height, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+		} else if height != c.expected || cs != c.consensusStates[c.expected] {
+			t.Errorf("%s: unexpected result: height=%d", c.name, height)
+		}
+	}
+
+	if l := (ProverConfig{}).GetMisbehaviourLookback(); l != DefaultMisbehaviourLookback {
+		t.Errorf("unexpected default lookback: %d", l)
+	}
+	if l := (ProverConfig{MisbehaviourLookback: 1000}).GetMisbehaviourLookback(); l != 1000 {
+		t.Errorf("unexpected lookback: %d", l)
+	}
+}
+
+func TestBuildMisbehaviour(t *testing.T) {
+	primary := newTestZKProverClient(mock.MockProverType, "")
+	trustedHeader, err := getHeader(primary.TMClient, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	trusted := &ConsensusState{
+		BlockHash: trustedHeader.Hash(),
+		AppHash:   trustedHeader.AppHash,
+		Timestamp: uint64(trustedHeader.Time.Add(-time.Second).UnixNano()),
+	}
+	validator := UpdateStateValidator{
+		StepVerifierDigest: primary.StepVerifierDigest,
+		SkipVerifierDigest: primary.SkipVerifierDigest,
+		TrustingPeriod:     time.Hour,
+	}
+	validate := func(msg *UpdateStateMessage) error {
+		return validator.Validate(msg, trusted, trustedHeader.Time)
+	}
+	witnessProver := func(c testWitnessClient) ZKProverClient {
+		zpc := primary
+		zpc.TMClient = c
+		return zpc
+	}
+
+	m, err := buildMisbehaviour(primary, witnessProver(testWitnessClient{forkHeight: 20}), 10, 20, validate)
+	if err != nil {
+		t.Fatal(err)
+	} else if m == nil {
+		t.Fatal("expected misbehaviour for the forked header")
+	}
+	forked, _ := getHeader(testWitnessClient{forkHeight: 20}, 20)
+	if !bytes.Equal(m.UpdateState2.UntrustedBlockHash, forked.Hash()) || !bytes.Equal(m.UpdateState2.AppHash, forked.AppHash) {
+		t.Errorf("the second message must prove the witness header: %v", m.UpdateState2)
+	}
+	if m.UpdateState1.TrustedHeight != 10 || m.UpdateState2.TrustedHeight != 10 || m.GetHeight().GetRevisionHeight() != 20 {
+		t.Errorf("unexpected heights: %v", m)
+	}
+
+	// the witnesses that don't prove a conflicting header
+	cases := []struct {
+		name    string
+		witness testWitnessClient
+		ok      bool
+	}{
+		{"same header", testWitnessClient{}, true},
+		{"fork above the height", testWitnessClient{forkHeight: 21}, true},
+		{"error", testWitnessClient{err: errors.New("unavailable")}, false},
+		{"header at another height", testWitnessClient{forkHeight: 20, heightDelta: 1}, false},
+		// the witness header is not derived from the trusted block
+		{"fork below the trusted height", testWitnessClient{forkHeight: 5}, false},
+	}
+	for _, c := range cases {
+		m, err := buildMisbehaviour(primary, witnessProver(c.witness), 10, 20, validate)
+		if m != nil || c.ok != (err == nil) {
+			t.Errorf("%s: unexpected result: misbehaviour=%v err=%v", c.name, m, err)
+		}
+	}
+
+	// the messages are validated before submission
+	expired := func(msg *UpdateStateMessage) error {
+		return validator.Validate(msg, trusted, trustedHeader.Time.Add(2*time.Hour))
+	}
+	if _, err := buildMisbehaviour(primary, witnessProver(testWitnessClient{forkHeight: 20}), 10, 20, expired); err == nil {
+		t.Error("expected an error for the expired trusted consensus state")
+	}
+}
+
+func TestBuildMisbehaviourRemoteProver(t *testing.T) {
+	_, _, proof := newTestGroth16VerifyingKey(t)
+	solidityProof := marshalSolidityGroth16Proof(t, proof)
+
+	// the remote prover reads the primary, so it cannot prove the witness header
+	var input [3]HexBigInt
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(ZKProofAndInputResponse{Input: input, Proof: solidityProof})
+	}))
+	defer srv.Close()
+
+	primary := newTestZKProverClient(groth16.Groth16ProverType, srv.URL)
+	input = testInputs(primary, 10, 20)
+	witness := primary
+	witness.TMClient = testWitnessClient{forkHeight: 20}
+	if m, err := buildMisbehaviour(primary, witness, 10, 20, func(*UpdateStateMessage) error { return nil }); m != nil || err == nil {
+		t.Errorf("unexpected result: misbehaviour=%v err=%v", m, err)
+	}
+}
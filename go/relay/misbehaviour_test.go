@@ -0,0 +1,85 @@
+package relay
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+func newTestCodec() codec.BinaryCodec {
+	registry := codectypes.NewInterfaceRegistry()
+	clienttypes.RegisterInterfaces(registry)
+	RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}
+
+func newTestUpdateStateMessage(trustedHeight, untrustedHeight uint64, blockHash byte) *UpdateStateMessage {
+	hash := make([]byte, 32)
+	hash[0] = blockHash
+	simpleTreeProof := make([][]byte, 6)
+	for i := range simpleTreeProof {
+		simpleTreeProof[i] = make([]byte, 32)
+	}
+	return &UpdateStateMessage{
+		TrustedHeight:      trustedHeight,
+		UntrustedHeight:    untrustedHeight,
+		UntrustedBlockHash: hash,
+		Timestamp:          1,
+		AppHash:            make([]byte, 32),
+		SimpleTreeProof:    simpleTreeProof,
+		Input:              [][]byte{{1}, {2}, {3}},
+		ZkProof:            []byte("mock"),
+	}
+}
+
+func TestMisbehaviourValidateBasic(t *testing.T) {
+	cases := []struct {
+		name string
+		m    Misbehaviour
+		ok   bool
+	}{
+		{"conflicting", *NewMisbehaviour(newTestUpdateStateMessage(1, 10, 1), newTestUpdateStateMessage(5, 10, 2)), true},
+		{"same block", *NewMisbehaviour(newTestUpdateStateMessage(1, 10, 1), newTestUpdateStateMessage(5, 10, 1)), false},
+		{"different heights", *NewMisbehaviour(newTestUpdateStateMessage(1, 10, 1), newTestUpdateStateMessage(5, 11, 2)), false},
+		{"nil", *NewMisbehaviour(newTestUpdateStateMessage(1, 10, 1), nil), false},
+	}
+	for _, c := range cases {
+		if err := c.m.ValidateBasic(); c.ok != (err == nil) {
+			t.Errorf("%s: unexpected result: %v", c.name, err)
+		}
+	}
+}
+
+func TestCheckForMisbehaviour(t *testing.T) {
+	cdc := newTestCodec()
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	cs := &ClientState{LatestHeight: clienttypes.NewHeight(REVISION_NUMBER, 10)}
+	stored := newTestUpdateStateMessage(1, 10, 1)
+	setConsensusState(store, cdc, &ConsensusState{BlockHash: stored.UntrustedBlockHash}, stored.GetHeight())
+
+	ctx := sdk.Context{}
+	if cs.CheckForMisbehaviour(ctx, cdc, store, stored) {
+		t.Error("the message matching the stored consensus state must not be misbehaviour")
+	}
+	if !cs.CheckForMisbehaviour(ctx, cdc, store, newTestUpdateStateMessage(5, 10, 2)) {
+		t.Error("the message conflicting with the stored consensus state must be misbehaviour")
+	}
+	if cs.CheckForMisbehaviour(ctx, cdc, store, newTestUpdateStateMessage(10, 11, 2)) {
+		t.Error("the message for a new height must not be misbehaviour")
+	}
+	if !cs.CheckForMisbehaviour(ctx, cdc, store, NewMisbehaviour(stored, newTestUpdateStateMessage(5, 10, 2))) {
+		t.Error("the misbehaviour must be detected")
+	}
+
+	cs.UpdateStateOnMisbehaviour(ctx, cdc, store, nil)
+	frozen := clienttypes.MustUnmarshalClientState(cdc, store.Get(host.ClientStateKey())).(*ClientState)
+	if !frozen.Frozen {
+		t.Error("the client must be frozen")
+	}
+}
@@ -5,7 +5,7 @@ import (
 )
 
 var (
-	existenceProofsABI, _ = abi.NewType("tuple[2]", "ExistenceProof[]", []abi.ArgumentMarshaling{
+	existenceProofComponents = []abi.ArgumentMarshaling{
 		{Name: "spec", Type: "uint8"},
 		{Name: "prefix", Type: "bytes"},
 		{Name: "key", Type: "bytes"},
@@ -14,6 +14,15 @@ var (
 			{Name: "prefix", Type: "bytes"},
 			{Name: "suffix", Type: "bytes"},
 		}},
+	}
+	existenceProofsABI, _    = abi.NewType("tuple[2]", "ExistenceProof[]", existenceProofComponents)
+	nonMembershipProofABI, _ = abi.NewType("tuple", "NonMembershipProof", []abi.ArgumentMarshaling{
+		{Name: "proof0", Type: "tuple", Components: []abi.ArgumentMarshaling{
+			{Name: "key", Type: "bytes"},
+			{Name: "left", Type: "tuple", Components: existenceProofComponents},
+			{Name: "right", Type: "tuple", Components: existenceProofComponents},
+		}},
+		{Name: "proof1", Type: "tuple", Components: existenceProofComponents},
 	})
 )
 
@@ -43,19 +52,35 @@ func EthABIDecodeExistenceProofs(data []byte) ([]ExistenceProof, error) {
 	if err != nil {
 		return nil, err
 	}
-	ps := v[0].([]struct {
-		Spec   uint8  `json:"spec"`
-		Prefix []byte `json:"prefix"`
-		Key    []byte `json:"key"`
-		Value  []byte `json:"value"`
-		Path   []struct {
-			Prefix []byte `json:"prefix"`
-			Suffix []byte `json:"suffix"`
-		} `json:"path"`
-	})
-	proofs := make([]ExistenceProof, 0)
-	for _, proof := range ps {
-		proofs = append(proofs, ExistenceProof(proof))
+	ps := *abi.ConvertType(v[0], new([2]ExistenceProof)).(*[2]ExistenceProof)
+	return ps[:], nil
+}
+
+type NonExistenceProof struct {
+	Key   []byte         `json:"key"`
+	Left  ExistenceProof `json:"left"`
+	Right ExistenceProof `json:"right"`
+}
+
+type NonMembershipProof struct {
+	Proof0 NonExistenceProof `json:"proof0"`
+	Proof1 ExistenceProof    `json:"proof1"`
+}
+
+func EthABIEncodeNonMembershipProof(proof NonMembershipProof) ([]byte, error) {
+	packer := abi.Arguments{
+		{Type: nonMembershipProofABI},
+	}
+	return packer.Pack(proof)
+}
+
+func EthABIDecodeNonMembershipProof(data []byte) (*NonMembershipProof, error) {
+	packer := abi.Arguments{
+		{Type: nonMembershipProofABI},
+	}
+	v, err := packer.Unpack(data)
+	if err != nil {
+		return nil, err
 	}
-	return proofs, nil
+	return abi.ConvertType(v[0], new(NonMembershipProof)).(*NonMembershipProof), nil
 }
@@ -0,0 +1,183 @@
+package relay
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ErrProofArtifactNotFound is returned when no proof artifact is stored for the heights
+var ErrProofArtifactNotFound = errors.New("proof artifact not found")
+
+// ProofArtifact is a zk proof persisted by the relayer so that it can be resubmitted without requesting the zk prover again
+type ProofArtifact struct {
+	TrustedHeight uint64        `json:"trusted_height"`
+	TargetHeight  uint64        `json:"target_height"`
+	ProverType    string        `json:"prover_type"`
+	Input         [3]HexBigInt  `json:"input"`
+	Proof         hexutil.Bytes `json:"proof"` // encoded by `ZKProof.EncodeEthABI`
+	CreatedAt     time.Time     `json:"created_at"`
+}
+
+// NewProofArtifact returns the artifact of the proof from `trustedHeight` to `targetHeight`
+func NewProofArtifact(trustedHeight, targetHeight uint64, p *ZKProofAndInput) *ProofArtifact {
+	return &ProofArtifact{
+		TrustedHeight: trustedHeight,
+		TargetHeight:  targetHeight,
+		ProverType:    p.Proof.ProverType(),
+		Input:         p.Input,
+		Proof:         p.Proof.EncodeEthABI(),
+		CreatedAt:     time.Now().UTC(),
+	}
+}
+
+// ZKProofAndInput decodes the stored proof with the proving system of `ProverType`
+func (a ProofArtifact) ZKProofAndInput() (*ZKProofAndInput, error) {
+	ps, err := zkp.Get(a.ProverType)
+	if err != nil {
+		return nil, err
+	}
+	p, err := ps.DecodeEthABI(a.Proof)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the stored proof: trusted_height=%d target_height=%d %w", a.TrustedHeight, a.TargetHeight, err)
+	}
+	return &ZKProofAndInput{Input: a.Input, Proof: p}, nil
+}
+
+// ProofStore persists proof artifacts as JSON files named `{trusted_height}-{target_height}.json`
+type ProofStore struct {
+	dir string
+}
+
+func NewProofStore(dir string) *ProofStore {
+	return &ProofStore{dir: dir}
+}
+
+func proofDir(home string, chainID string) string {
+	return path.Join(home, "proofs", chainID)
+}
+
+func (s *ProofStore) path(trustedHeight, targetHeight uint64) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%d-%d.json", trustedHeight, targetHeight))
+}
+
+// Put writes the artifact, replacing the one for the same heights
+func (s *ProofStore) Put(a *ProofArtifact) error {
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return err
+	}
+	bz, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		return err
+	}
+	// write to a temporary file first so that a crash never leaves a truncated artifact
+	f, err := os.CreateTemp(s.dir, ".proof-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(bz); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), s.path(a.TrustedHeight, a.TargetHeight))
+}
+
+// Get returns the artifact of the proof from `trustedHeight` to `targetHeight`
+func (s *ProofStore) Get(trustedHeight, targetHeight uint64) (*ProofArtifact, error) {
+	bz, err := os.ReadFile(s.path(trustedHeight, targetHeight))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: trusted_height=%d target_height=%d", ErrProofArtifactNotFound, trustedHeight, targetHeight)
+	} else if err != nil {
+		return nil, err
+	}
+	var a ProofArtifact
+	if err := json.Unmarshal(bz, &a); err != nil {
+		return nil, fmt.Errorf("invalid proof artifact: trusted_height=%d target_height=%d %w", trustedHeight, targetHeight, err)
+	}
+	return &a, nil
+}
+
+// Delete removes the artifact. It is not an error if the artifact doesn't exist.
+func (s *ProofStore) Delete(trustedHeight, targetHeight uint64) error {
+	if err := os.Remove(s.path(trustedHeight, targetHeight)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// List returns all the artifacts sorted by the trusted height and the target height
+func (s *ProofStore) List() ([]*ProofArtifact, error) {
+	entries, err := os.ReadDir(s.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var artifacts []*ProofArtifact
+	for _, e := range entries {
+		var trustedHeight, targetHeight uint64
+		if e.IsDir() {
+			continue
+		} else if _, err := fmt.Sscanf(e.Name(), "%d-%d.json", &trustedHeight, &targetHeight); err != nil {
+			continue
+		}
+		a, err := s.Get(trustedHeight, targetHeight)
+		if err != nil {
+			return nil, err
+		}
+		artifacts = append(artifacts, a)
+	}
+	sort.Slice(artifacts, func(i, j int) bool {
+		if artifacts[i].TrustedHeight != artifacts[j].TrustedHeight {
+			return artifacts[i].TrustedHeight < artifacts[j].TrustedHeight
+		}
+		return artifacts[i].TargetHeight < artifacts[j].TargetHeight
+	})
+	return artifacts, nil
+}
+
+// Latest returns the artifact with the highest target height among the proofs from `trustedHeight` generated by `proverType`, or nil if there is none
+func (s *ProofStore) Latest(trustedHeight uint64, proverType string) (*ProofArtifact, error) {
+	artifacts, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+	var latest *ProofArtifact
+	for _, a := range artifacts {
+		if a.TrustedHeight == trustedHeight && a.ProverType == proverType {
+			latest = a
+		}
+	}
+	return latest, nil
+}
+
+// Prune removes the artifacts whose trusted height is below `trustedHeight` and returns the number of the removed ones
+func (s *ProofStore) Prune(trustedHeight uint64) (int, error) {
+	artifacts, err := s.List()
+	if err != nil {
+		return 0, err
+	}
+	var n int
+	for _, a := range artifacts {
+		if a.TrustedHeight >= trustedHeight {
+			break
+		}
+		if err := s.Delete(a.TrustedHeight, a.TargetHeight); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
@@ -0,0 +1,112 @@
+package relay
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp/mock"
+)
+
+func TestProofStore(t *testing.T) {
+	store := NewProofStore(filepath.Join(t.TempDir(), "proofs", "ibc0"))
+	if artifacts, err := store.List(); err != nil || len(artifacts) != 0 {
+		t.Fatalf("unexpected result for an empty store: %v %v", artifacts, err)
+	}
+
+	newArtifact := func(trusted, target uint64) *ProofArtifact {
+		var p ZKProofAndInput
+		for i := range p.Input {
+			p.Input[i] = HexBigInt(*big.NewInt(int64(target*10 + uint64(i))))
+		}
+		p.Proof = mock.GetMockProof()
+		return NewProofArtifact(trusted, target, &p)
+	}
+	for _, a := range []*ProofArtifact{newArtifact(10, 30), newArtifact(10, 20), newArtifact(5, 10), newArtifact(20, 21)} {
+		if err := store.Put(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// files other than artifacts are ignored
+	if err := os.WriteFile(filepath.Join(store.dir, "README"), nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	artifacts, err := store.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var heights [][2]uint64
+	for _, a := range artifacts {
+		heights = append(heights, [2]uint64{a.TrustedHeight, a.TargetHeight})
+	}
+	if expected := [][2]uint64{{5, 10}, {10, 20}, {10, 30}, {20, 21}}; len(heights) != len(expected) {
+		t.Fatalf("unexpected artifacts: %v", heights)
+	} else {
+		for i := range expected {
+			if heights[i] != expected[i] {
+				t.Fatalf("unexpected artifacts: %v", heights)
+			}
+		}
+	}
+
+	a, err := store.Get(10, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := a.ZKProofAndInput()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Proof.ProverType() != mock.MockProverType || !bytes.Equal(p.Proof.EncodeEthABI(), mock.GetMockProof().EncodeEthABI()) {
+		t.Errorf("unexpected proof: %v", p.Proof)
+	}
+	if in := big.Int(p.Input[2]); in.Cmp(big.NewInt(202)) != 0 {
+		t.Errorf("unexpected input: %v", &in)
+	}
+
+	if latest, err := store.Latest(10, mock.MockProverType); err != nil || latest == nil || latest.TargetHeight != 30 {
+		t.Errorf("unexpected latest artifact: %v %v", latest, err)
+	}
+	if latest, err := store.Latest(10, "groth16"); err != nil || latest != nil {
+		t.Errorf("unexpected latest artifact of another prover type: %v %v", latest, err)
+	}
+
+	if err := store.Delete(10, 30); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Delete(10, 30); err != nil {
+		t.Errorf("deleting a missing artifact must not fail: %v", err)
+	}
+	if _, err := store.Get(10, 30); !errors.Is(err, ErrProofArtifactNotFound) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if latest, err := store.Latest(10, mock.MockProverType); err != nil || latest == nil || latest.TargetHeight != 20 {
+		t.Errorf("unexpected latest artifact: %v %v", latest, err)
+	}
+}
+
+func TestProofStorePrune(t *testing.T) {
+	store := NewProofStore(filepath.Join(t.TempDir(), "proofs", "ibc0"))
+	if n, err := store.Prune(10); err != nil || n != 0 {
+		t.Fatalf("unexpected result for an empty store: %v %v", n, err)
+	}
+	for _, h := range [][2]uint64{{5, 10}, {5, 20}, {9, 12}, {10, 20}, {12, 15}} {
+		if err := store.Put(NewProofArtifact(h[0], h[1], &ZKProofAndInput{Proof: mock.GetMockProof()})); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n, err := store.Prune(10); err != nil || n != 3 {
+		t.Fatalf("unexpected result: %v %v", n, err)
+	}
+	artifacts, err := store.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(artifacts) != 2 || artifacts[0].TrustedHeight != 10 || artifacts[1].TrustedHeight != 12 {
+		t.Errorf("unexpected artifacts: %v", artifacts)
+	}
+}
@@ -1,6 +1,7 @@
 package relay
 
 import (
+	"bytes"
 	"reflect"
 	"testing"
 )
@@ -59,3 +60,58 @@ func TestExistenceProofEncoding(t *testing.T) {
 		}
 	}
 }
+
+func TestNonMembershipProofEncoding(t *testing.T) {
+	var proof = NonMembershipProof{
+		Proof0: NonExistenceProof{
+			Key: []byte{0x01},
+			Left: ExistenceProof{
+				Spec:   IAVLSpec,
+				Prefix: []byte{0x02},
+				Key:    []byte{0x00},
+				Value:  []byte{0x03},
+				Path: []struct {
+					Prefix []byte `json:"prefix"`
+					Suffix []byte `json:"suffix"`
+				}{
+					{
+						Prefix: []byte{0x04},
+						Suffix: []byte{0x05},
+					},
+				},
+			},
+			Right: ExistenceProof{
+				Spec: IAVLSpec,
+			},
+		},
+		Proof1: ExistenceProof{
+			Spec:   SimpleTree,
+			Prefix: []byte{0x06},
+			Key:    []byte{0x07},
+			Value:  []byte{0x08},
+			Path: []struct {
+				Prefix []byte `json:"prefix"`
+				Suffix []byte `json:"suffix"`
+			}{
+				{
+					Prefix: []byte{0x09},
+					Suffix: []byte{0x0a},
+				},
+			},
+		},
+	}
+	bz, err := EthABIEncodeNonMembershipProof(proof)
+	if err != nil {
+		t.Fatalf("EthABIEncodeNonMembershipProof failed: %v", err)
+	}
+	proof2, err := EthABIDecodeNonMembershipProof(bz)
+	if err != nil {
+		t.Fatalf("EthABIDecodeNonMembershipProof failed: %v", err)
+	}
+	if !reflect.DeepEqual(proof.Proof0.Left, proof2.Proof0.Left) || !bytes.Equal(proof.Proof0.Key, proof2.Proof0.Key) || !reflect.DeepEqual(proof.Proof1, proof2.Proof1) {
+		t.Fatalf("EthABIDecodeNonMembershipProof failed: mismatch")
+	}
+	if proof2.Proof0.Right.Spec != IAVLSpec || len(proof2.Proof0.Right.Key) != 0 {
+		t.Fatalf("EthABIDecodeNonMembershipProof failed: unexpected right proof: %v", proof2.Proof0.Right)
+	}
+}
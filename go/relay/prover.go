@@ -4,14 +4,18 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"math"
+	"math/big"
 	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	cometbfttypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
-	ibcclient "github.com/cosmos/ibc-go/v7/modules/core/client"
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 	tmclient "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
 	"github.com/hyperledger-labs/yui-relayer/chains/tendermint"
@@ -29,12 +33,15 @@ type Prover struct {
 }
 
 func NewProver(chain *tendermint.Chain, config ProverConfig) *Prover {
-	return &Prover{chain: chain, config: config, zkProverClient: NewZKProverClient(config.ProverType, config.ZkProverAddr, config.GetStepVerifierDigest(), config.GetSkipVerifierDigest(), chain.Client)}
+	return &Prover{chain: chain, config: config, zkProverClient: NewZKProverClient(config.ProverType, config.ZkProverAddr, config.GnarkDataDir, config.VerifyingKeyPath, config.GetStepVerifierDigest(), config.GetSkipVerifierDigest(), chain.Client)}
 }
 
 func (pr *Prover) Init(homePath string, timeout time.Duration, codec codec.ProtoCodecMarshaler, debug bool) error {
 	// TODO fix this
 	pr.zkProverClient.TMClient = pr.chain.Client
+	if err := pr.zkProverClient.LoadVerifyingKey(); err != nil {
+		return fmt.Errorf("failed to load the verifying key %v: %w", pr.config.VerifyingKeyPath, err)
+	}
 	return nil
 }
 
@@ -49,30 +56,87 @@ func (pr *Prover) SetupForRelay(ctx context.Context) error {
 
 // ProveState returns the proof of an IBC state specified by `path` and `value`
 func (pr *Prover) ProveState(ctx core.QueryContext, path string, value []byte) ([]byte, clienttypes.Height, error) {
-	clientCtx := pr.chain.CLIContext(int64(ctx.Height().GetRevisionHeight()))
-	height := int64(ctx.Height().GetRevisionHeight())
-	res, err := pr.chain.Client.Header(ctx.Context(), &height)
+	v, merkleProof, proofHeight, appHash, err := pr.queryMerkleProof(ctx, path)
 	if err != nil {
-		return nil, clienttypes.ZeroHeight(), err
-	}
-	if v, proof, proofHeight, err := ibcclient.QueryTendermintProof(clientCtx, []byte(path)); err != nil {
 		return nil, clienttypes.Height{}, err
 	} else if !bytes.Equal(v, value) {
 		return nil, clienttypes.Height{}, fmt.Errorf("value unmatch: %x != %x", v, value)
-	} else {
-		var merkleProof commitmenttypes.MerkleProof
-		if err := pr.chain.Codec().Unmarshal(proof, &merkleProof); err != nil {
-			return nil, clienttypes.Height{}, fmt.Errorf("failed to unmarshal merkle proof: %v", err)
-		}
-		if len(merkleProof.Proofs) != 2 {
-			return nil, clienttypes.Height{}, fmt.Errorf("invalid merkle proof: %v", merkleProof)
-		}
-		exitProof, err := verifyAndConvertToExistenceProof([32]byte(res.Header.AppHash), path, value, &merkleProof)
-		if err != nil {
-			return nil, clienttypes.Height{}, err
-		}
-		return exitProof, proofHeight, nil
 	}
+	exitProof, err := verifyAndConvertToExistenceProof(pr.config.GetStoreLayout(), appHash, path, value, merkleProof)
+	if err != nil {
+		return nil, clienttypes.Height{}, err
+	}
+	return exitProof, proofHeight, nil
+}
+
+// ProveNonMembership returns the proof of the absence of an IBC state specified by `path`
+func (pr *Prover) ProveNonMembership(ctx core.QueryContext, path string) ([]byte, clienttypes.Height, error) {
+	v, merkleProof, proofHeight, appHash, err := pr.queryMerkleProof(ctx, path)
+	if err != nil {
+		return nil, clienttypes.Height{}, err
+	} else if len(v) != 0 {
+		return nil, clienttypes.Height{}, fmt.Errorf("value exists: path=%v value=%x", path, v)
+	}
+	nonExistProof, err := verifyAndConvertToNonExistenceProof(pr.config.GetStoreLayout(), appHash, path, merkleProof)
+	if err != nil {
+		return nil, clienttypes.Height{}, err
+	}
+	return nonExistProof, proofHeight, nil
+}
+
+// queryMerkleProof returns the value and the merkle proof of `path` with the app hash of the header at the height of `ctx`
+func (pr *Prover) queryMerkleProof(ctx core.QueryContext, path string) ([]byte, *commitmenttypes.MerkleProof, clienttypes.Height, [32]byte, error) {
+	appHash, err := pr.queryAppHash(ctx)
+	if err != nil {
+		return nil, nil, clienttypes.ZeroHeight(), [32]byte{}, err
+	}
+	v, merkleProof, proofHeight, err := pr.queryTendermintProof(ctx, path)
+	if err != nil {
+		return nil, nil, clienttypes.Height{}, [32]byte{}, err
+	}
+	return v, merkleProof, proofHeight, appHash, nil
+}
+
+// queryAppHash returns the app hash of the header at the height of `ctx`
+func (pr *Prover) queryAppHash(ctx core.QueryContext) ([32]byte, error) {
+	height := int64(ctx.Height().GetRevisionHeight())
+	res, err := pr.chain.Client.Header(ctx.Context(), &height)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if len(res.Header.AppHash) != 32 {
+		return [32]byte{}, fmt.Errorf("invalid app hash: height=%d app_hash=%X", height, res.Header.AppHash)
+	}
+	return [32]byte(res.Header.AppHash), nil
+}
+
+// queryTendermintProof returns the value and the merkle proof of `path` in the configured store at the height of `ctx`
+// This is the same as `ibcclient.QueryTendermintProof` except that the store key is configurable.
+func (pr *Prover) queryTendermintProof(ctx core.QueryContext, path string) ([]byte, *commitmenttypes.MerkleProof, clienttypes.Height, error) {
+	height := int64(ctx.Height().GetRevisionHeight())
+	// ABCI queries at heights 1, 2 or less than or equal to 0 are not supported.
+	if height <= 2 {
+		return nil, nil, clienttypes.Height{}, fmt.Errorf("proof queries at height <= 2 are not supported")
+	}
+	// the state at the height `h` is committed to the app hash of the header at `h+1`
+	res, err := pr.chain.CLIContext(height).QueryABCI(abci.RequestQuery{
+		Path:   fmt.Sprintf("store/%s/key", pr.config.GetStoreLayout().StoreKey),
+		Height: height - 1,
+		Data:   []byte(path),
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, nil, clienttypes.Height{}, err
+	}
+	merkleProof, err := commitmenttypes.ConvertProofs(res.ProofOps)
+	if err != nil {
+		return nil, nil, clienttypes.Height{}, fmt.Errorf("failed to convert the proof ops: %v", err)
+	}
+	if len(merkleProof.Proofs) != 2 {
+		return nil, nil, clienttypes.Height{}, fmt.Errorf("invalid merkle proof: %v", merkleProof)
+	}
+	revision := clienttypes.ParseChainID(pr.chain.ChainID())
+	return res.Value, &merkleProof, clienttypes.NewHeight(revision, uint64(res.Height)+1), nil
 }
 
 // ProveHostConsensusState returns the existence proof of the consensus state at `height`
@@ -98,6 +162,10 @@ func (pr *Prover) CreateInitialLightClientState(height ibcexported.Height) (ibce
 		Frozen:             false,
 		LatestHeight:       selfHeader.GetHeight().(clienttypes.Height),
 	}
+	// the contract rejects the client state unless the specs match its `proofSpecs()`, which omits the default ones
+	if specs := pr.config.GetStoreLayout().ProofSpecs(); specs != DefaultStoreLayout.ProofSpecs() {
+		clientState.ProofSpecs = []uint32{uint32(specs[0]), uint32(specs[1])}
+	}
 	if len(clientState.StepVerifierDigest) != 32 {
 		return nil, nil, fmt.Errorf("relayer: invalid step verifier digest: %x", clientState.StepVerifierDigest)
 	}
@@ -133,20 +201,158 @@ func (pr *Prover) SetupHeadersForUpdate(counterparty core.FinalityAwareChain, la
 		return nil, err
 	}
 
+	if zkcs, ok := cs.(*ClientState); ok && zkcs.Frozen {
+		return nil, fmt.Errorf("the counterparty client is frozen")
+	}
+
 	log := getLogger()
 
+	ctx := core.NewQueryContext(context.TODO(), cph)
+	trustedConsensusState, err := pr.verifyCounterpartyConsensusState(counterparty, ctx, cs.GetLatestHeight())
+	if err != nil {
+		var divergenceErr *DivergenceError
+		switch {
+		case errors.Is(err, ErrConsensusStateMismatch):
+			log.Error("refusing to relay: the counterparty client may have been updated with a forked header", err, "trusted_height", cs.GetLatestHeight().String())
+			if pr.config.ReportMisbehaviour {
+				if err := pr.ReportConflictingConsensusState(counterparty, ctx, cs.GetLatestHeight()); err != nil {
+					log.Error("failed to report the conflicting consensus state", err)
+				}
+			}
+		case errors.As(err, &divergenceErr) && pr.config.ReportMisbehaviour:
+			log.Error("the light client detected divergence between the primary and a witness", err)
+			height := clienttypes.NewHeight(cs.GetLatestHeight().GetRevisionNumber(), uint64(divergenceErr.Height))
+			if err := pr.ReportDivergence(counterparty, ctx, height); err != nil {
+				log.Error("failed to report the divergence", err)
+			}
+		}
+		return nil, err
+	}
+
 	trustedHeight := cs.GetLatestHeight().GetRevisionHeight()
-	targetHeight := h.GetHeight().GetRevisionHeight()
+	// the proofs from the heights below the latest one can no longer be submitted
+	if n, err := pr.ProofStore().Prune(trustedHeight); err != nil {
+		log.Error("failed to prune the stored proofs", err)
+	} else if n > 0 {
+		log.Info("pruned the stored proofs", "count", n, "trusted_height", trustedHeight)
+	}
+	// a proof from the same trusted height is stored if the previous update failed on the counterparty chain
+	if a, err := pr.ProofStore().Latest(trustedHeight, pr.config.ProverType); err != nil {
+		log.Error("failed to read the stored proofs", err)
+	} else if a != nil && a.TargetHeight <= h.GetHeight().GetRevisionHeight() {
+		log.Info("reusing the stored proof", "trusted_height", trustedHeight, "target_height", a.TargetHeight)
+		if h, err = pr.UpdateLightClient(int64(a.TargetHeight)); err != nil {
+			return nil, fmt.Errorf("failed to get the header@%d from the local light client: %w", a.TargetHeight, err)
+		}
+	}
+
+	msg, err := pr.buildUpdateStateMessage(trustedHeight, h)
+	if err != nil {
+		return nil, err
+	}
+	// reject the message before it costs gas on the counterparty chain
+	if err := NewUpdateStateValidator(pr.config, pr.zkProverClient.ZKProofVerifier()).Validate(msg, trustedConsensusState, time.Now()); err != nil {
+		// never reuse the proof of an invalid message
+		if err := pr.ProofStore().Delete(msg.TrustedHeight, msg.UntrustedHeight); err != nil {
+			log.Error("failed to delete the stored proof", err)
+		}
+		return nil, fmt.Errorf("invalid update state message: %w", err)
+	}
+	log.Info("created update state message", "msg", msg)
+	return []core.Header{msg}, nil
+}
+
+// BuildUpdateStateMessage returns the UpdateStateMessage that updates the client from `trustedHeight` to `targetHeight`
+func (pr *Prover) BuildUpdateStateMessage(trustedHeight, targetHeight uint64) (*UpdateStateMessage, error) {
+	h, err := pr.UpdateLightClient(int64(targetHeight))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the header@%d from the local light client: %w", targetHeight, err)
+	}
+	return pr.buildUpdateStateMessage(trustedHeight, h)
+}
+
+// ProveUpdateState requests a new proof from the ZK prover regardless of the stored proofs and returns it with the UpdateStateMessage that updates the client from `trustedHeight` to `targetHeight`
+func (pr *Prover) ProveUpdateState(trustedHeight, targetHeight uint64) (*ZKProofAndInput, *UpdateStateMessage, error) {
+	h, err := pr.UpdateLightClient(int64(targetHeight))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get the header@%d from the local light client: %w", targetHeight, err)
+	}
+	var zkProof *ZKProofAndInput
+	msg, err := pr.buildUpdateStateMessageWithProof(trustedHeight, h, func(trustedHeight, targetHeight uint64) (*ZKProofAndInput, error) {
+		zkProof, err = pr.zkProverClient.Prove(trustedHeight, targetHeight)
+		return zkProof, err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return zkProof, msg, nil
+}
+
+// buildUpdateStateMessage requests a proof of `h` from the ZK prover and returns the UpdateStateMessage that updates the client from `trustedHeight` to the height of `h`
+func (pr *Prover) buildUpdateStateMessage(trustedHeight uint64, h *tmclient.Header) (*UpdateStateMessage, error) {
+	return pr.buildUpdateStateMessageWithProof(trustedHeight, h, pr.getZKProof)
+}
+
+// buildUpdateStateMessageWithProof returns the UpdateStateMessage with the proof returned by `prove`
+func (pr *Prover) buildUpdateStateMessageWithProof(trustedHeight uint64, h *tmclient.Header, prove func(trustedHeight, targetHeight uint64) (*ZKProofAndInput, error)) (*UpdateStateMessage, error) {
+	tmHeader, err := cometbfttypes.HeaderFromProto(h.SignedHeader.Header)
+	if err != nil {
+		return nil, err
+	}
+	return newUpdateStateMessage(trustedHeight, &tmHeader, prove)
+}
+
+// newUpdateStateMessage returns the UpdateStateMessage that updates the client from `trustedHeight` to `header` with the proof returned by `prove`
+func newUpdateStateMessage(trustedHeight uint64, header *cometbfttypes.Header, prove func(trustedHeight, targetHeight uint64) (*ZKProofAndInput, error)) (*UpdateStateMessage, error) {
+	targetHeight := uint64(header.Height)
 	if trustedHeight >= targetHeight {
 		return nil, fmt.Errorf("trusted height is greater than target height: trusted_height: %d, target_height: %d", trustedHeight, targetHeight)
 	}
-	targetHeightInt := int64(targetHeight)
-	res, err := pr.chain.Client.Header(context.TODO(), &targetHeightInt)
+	// build the simple tree proof before requesting the zk proof so that a malformed header fails fast
+	simpleTreeProof, err := getSimpleTreeProof(header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build the simple tree proof: height=%d %w", targetHeight, err)
+	}
+
+	zkProof, err := prove(trustedHeight, targetHeight)
 	if err != nil {
 		return nil, err
 	}
+	return &UpdateStateMessage{
+		TrustedHeight:      trustedHeight,
+		UntrustedHeight:    targetHeight,
+		UntrustedBlockHash: header.Hash(),
+		Timestamp:          uint64(header.Time.UnixNano()),
+		AppHash:            header.AppHash,
+		SimpleTreeProof:    simpleTreeProof[:],
+		Input:              [][]byte{zkProof.Input[0].Bytes(), zkProof.Input[1].Bytes(), zkProof.Input[2].Bytes()},
+		ZkProof:            zkProof.Proof.EncodeEthABI(),
+	}, nil
+}
+
+// getZKProof returns the stored proof from `trustedHeight` to `targetHeight` if any, otherwise requests it from the ZK prover and stores it
+func (pr *Prover) getZKProof(trustedHeight, targetHeight uint64) (*ZKProofAndInput, error) {
+	log := getLogger()
+	store := pr.ProofStore()
+	if a, err := store.Get(trustedHeight, targetHeight); err == nil && a.ProverType == pr.config.ProverType {
+		// the stored proof is checked as a new one is, so a corrupted or stale artifact is never submitted
+		zkProof, err := a.ZKProofAndInput()
+		if err == nil {
+			err = pr.zkProverClient.VerifyProof(trustedHeight, targetHeight, zkProof)
+		}
+		if err == nil {
+			log.Info("got stored proof", "trusted_height", trustedHeight, "target_height", targetHeight)
+			return zkProof, nil
+		}
+		log.Error("discarding the invalid stored proof", err, "trusted_height", trustedHeight, "target_height", targetHeight)
+		if err := store.Delete(trustedHeight, targetHeight); err != nil {
+			log.Error("failed to delete the stored proof", err)
+		}
+	} else if err != nil && !errors.Is(err, ErrProofArtifactNotFound) {
+		log.Error("failed to read the stored proof", err)
+	}
 
-	proofCh := pr.zkProverClient.AsyncProve(cs.GetLatestHeight().GetRevisionHeight(), h.GetHeight().GetRevisionHeight())
+	proofCh := pr.zkProverClient.AsyncProve(trustedHeight, targetHeight)
 	tick := time.NewTicker(10 * time.Second)
 	defer tick.Stop()
 	timeout := time.After(10 * time.Minute)
@@ -169,23 +375,105 @@ L:
 			log.Info("waiting for proving", "trusted_height", trustedHeight, "target_height", targetHeight)
 		}
 	}
-	tmHeader, err := cometbfttypes.HeaderFromProto(h.SignedHeader.Header)
+	// failing to persist the proof only costs a new proof on retry
+	if err := store.Put(NewProofArtifact(trustedHeight, targetHeight, zkProof)); err != nil {
+		log.Error("failed to store the proof", err, "trusted_height", trustedHeight, "target_height", targetHeight)
+	}
+	return zkProof, nil
+}
+
+// ProofStore returns the store of the proofs generated for this chain under the relayer home directory
+func (pr *Prover) ProofStore() *ProofStore {
+	return NewProofStore(proofDir(pr.chain.HomePath, pr.chain.ChainID()))
+}
+
+// ResubmitProof submits the UpdateStateMessage with the stored proof from `trustedHeight` to `targetHeight` to the client on the counterparty chain.
+// If `targetHeight` is zero, the stored proof with the highest target height from the latest height of the client is used.
+func (pr *Prover) ResubmitProof(counterparty core.FinalityAwareChain, trustedHeight, targetHeight uint64) (*UpdateStateMessage, error) {
+	cph, err := counterparty.LatestHeight()
 	if err != nil {
 		return nil, err
 	}
-	simpleTreeProof := getSimpleTreeProof(&tmHeader)
-	msg := UpdateStateMessage{
-		TrustedHeight:      trustedHeight,
-		UntrustedHeight:    targetHeight,
-		UntrustedBlockHash: res.Header.Hash(),
-		Timestamp:          uint64(h.Header.Time.UnixNano()),
-		AppHash:            h.Header.AppHash,
-		SimpleTreeProof:    simpleTreeProof[:],
-		Input:              [][]byte{zkProof.Input[0].Bytes(), zkProof.Input[1].Bytes(), zkProof.Input[2].Bytes()},
-		ZkProof:            zkProof.Proof.EncodeEthABI(),
+	ctx := core.NewQueryContext(context.TODO(), cph)
+	res, err := counterparty.QueryClientState(ctx)
+	if err != nil {
+		return nil, err
 	}
-	log.Info("created update state message", "msg", msg)
-	return []core.Header{&msg}, nil
+	var cs ibcexported.ClientState
+	if err := pr.chain.Codec().UnpackAny(res.ClientState, &cs); err != nil {
+		return nil, err
+	}
+	if trustedHeight == 0 {
+		trustedHeight = cs.GetLatestHeight().GetRevisionHeight()
+	}
+	var a *ProofArtifact
+	if targetHeight == 0 {
+		if a, err = pr.ProofStore().Latest(trustedHeight, pr.config.ProverType); err != nil {
+			return nil, err
+		} else if a == nil {
+			return nil, fmt.Errorf("%w: trusted_height=%d prover_type=%v", ErrProofArtifactNotFound, trustedHeight, pr.config.ProverType)
+		}
+	} else if a, err = pr.ProofStore().Get(trustedHeight, targetHeight); err != nil {
+		return nil, err
+	}
+	if a.ProverType != pr.config.ProverType {
+		return nil, fmt.Errorf("the stored proof is generated by another prover type: expected=%v actual=%v", pr.config.ProverType, a.ProverType)
+	}
+
+	trustedConsensusState, err := pr.verifyCounterpartyConsensusState(counterparty, ctx, clienttypes.NewHeight(cs.GetLatestHeight().GetRevisionNumber(), a.TrustedHeight))
+	if err != nil {
+		return nil, err
+	}
+	msg, err := pr.BuildUpdateStateMessage(a.TrustedHeight, a.TargetHeight)
+	if err != nil {
+		return nil, err
+	}
+	if err := NewUpdateStateValidator(pr.config, pr.zkProverClient.ZKProofVerifier()).Validate(msg, trustedConsensusState, time.Now()); err != nil {
+		return nil, fmt.Errorf("invalid update state message: %w", err)
+	}
+	signer, err := counterparty.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+	updateMsg, err := clienttypes.NewMsgUpdateClient(counterparty.Path().ClientID, msg, signer.String())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := counterparty.SendMsgs([]sdk.Msg{updateMsg}); err != nil {
+		return nil, fmt.Errorf("failed to submit the update state message: %w", err)
+	}
+	getLogger().Info("resubmitted the stored proof", "client_id", counterparty.Path().ClientID, "trusted_height", a.TrustedHeight, "target_height", a.TargetHeight)
+	return msg, nil
+}
+
+// verifyCounterpartyConsensusState checks that the consensus state stored in the counterparty client at `height` matches the header verified by the local light client and returns it
+func (pr *Prover) verifyCounterpartyConsensusState(counterparty core.ICS02Querier, ctx core.QueryContext, height ibcexported.Height) (*ConsensusState, error) {
+	consensusState, err := pr.QueryCounterpartyConsensusState(counterparty, ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	header, err := pr.UpdateLightClient(int64(height.GetRevisionHeight()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the header@%v from the local light client: %w", height, err)
+	}
+	if err := compareConsensusState(consensusState, header); err != nil {
+		return nil, err
+	}
+	return consensusState, nil
+}
+
+// compareConsensusState returns ErrConsensusStateMismatch if `cs` is not derived from `header`
+func compareConsensusState(cs *ConsensusState, header *tmclient.Header) error {
+	if blockHash := header.SignedHeader.Commit.BlockID.Hash; !bytes.Equal(cs.BlockHash, blockHash) {
+		return fmt.Errorf("%w: height=%v block_hash: expected=%X actual=%X", ErrConsensusStateMismatch, header.GetHeight(), blockHash, cs.BlockHash)
+	}
+	if appHash := header.Header.AppHash; !bytes.Equal(cs.AppHash, appHash) {
+		return fmt.Errorf("%w: height=%v app_hash: expected=%X actual=%X", ErrConsensusStateMismatch, header.GetHeight(), appHash, cs.AppHash)
+	}
+	if timestamp := uint64(header.Header.Time.UnixNano()); cs.Timestamp != timestamp {
+		return fmt.Errorf("%w: height=%v timestamp: expected=%v actual=%v", ErrConsensusStateMismatch, header.GetHeight(), timestamp, cs.Timestamp)
+	}
+	return nil
 }
 
 // GetLatestFinalizedHeader returns the latest finalized header
@@ -193,9 +481,71 @@ func (pr *Prover) GetLatestFinalizedHeader() (core.Header, error) {
 	return pr.UpdateLightClient(0)
 }
 
+// CheckRefreshRequired returns true if the time elapsed since the latest consensus state of the counterparty client exceeds the refresh threshold of the trusting period
 func (pr *Prover) CheckRefreshRequired(counterparty core.ChainInfoICS02Querier) (bool, error) {
-	// TODO implement
-	return false, nil
+	cpQueryHeight, err := counterparty.LatestHeight()
+	if err != nil {
+		return false, fmt.Errorf("failed to get the latest height of the counterparty chain: %v", err)
+	}
+	cpQueryCtx := core.NewQueryContext(context.TODO(), cpQueryHeight)
+
+	resCs, err := counterparty.QueryClientState(cpQueryCtx)
+	if err != nil {
+		return false, fmt.Errorf("failed to query the client state on the counterparty chain: %v", err)
+	}
+	var cs ibcexported.ClientState
+	if err := pr.chain.Codec().UnpackAny(resCs.ClientState, &cs); err != nil {
+		return false, fmt.Errorf("failed to unpack Any into tendermint-zk client state: %v", err)
+	}
+	clientState, ok := cs.(*ClientState)
+	if !ok {
+		return false, fmt.Errorf("unexpected client state type: %T", cs)
+	}
+
+	resCons, err := counterparty.QueryClientConsensusState(cpQueryCtx, cs.GetLatestHeight())
+	if err != nil {
+		return false, fmt.Errorf("failed to query the consensus state on the counterparty chain: %v", err)
+	}
+	var cons ibcexported.ConsensusState
+	if err := pr.chain.Codec().UnpackAny(resCons.ConsensusState, &cons); err != nil {
+		return false, fmt.Errorf("failed to unpack Any into tendermint-zk consensus state: %v", err)
+	}
+
+	selfQueryHeight, err := pr.chain.LatestHeight()
+	if err != nil {
+		return false, fmt.Errorf("failed to get the latest height of the self chain: %v", err)
+	}
+	selfTimestamp, err := pr.chain.Timestamp(selfQueryHeight)
+	if err != nil {
+		return false, fmt.Errorf("failed to get timestamp of the self chain: %v", err)
+	}
+	return pr.isRefreshRequired(cs.GetLatestHeight(), cons, time.Duration(clientState.TrustingPeriod), selfTimestamp), nil
+}
+
+// isRefreshRequired returns true if the time elapsed from the consensus state `cons` to `selfTimestamp` exceeds the refresh threshold of `trustingPeriod`
+func (pr *Prover) isRefreshRequired(height ibcexported.Height, cons ibcexported.ConsensusState, trustingPeriod time.Duration, selfTimestamp time.Time) bool {
+	lcLastTimestamp := time.Unix(0, int64(cons.GetTimestamp()))
+	elapsedTime := selfTimestamp.Sub(lcLastTimestamp)
+	threshold := durationMulByFraction(trustingPeriod, pr.config.GetRefreshThresholdRate())
+	needsRefresh := elapsedTime > threshold
+	if needsRefresh {
+		getLogger().Info("refresh required", "latest_height", height.String(), "elapsed", elapsedTime.String(), "threshold", threshold.String())
+	}
+	return needsRefresh
+}
+
+// durationMulByFraction returns `d * f` rounded down, saturating at the bounds of time.Duration
+func durationMulByFraction(d time.Duration, f Fraction) time.Duration {
+	// the intermediate product can exceed int64 even if the result fits
+	nsec := new(big.Int).Mul(big.NewInt(d.Nanoseconds()), new(big.Int).SetUint64(f.Numerator))
+	nsec.Quo(nsec, new(big.Int).SetUint64(f.Denominator))
+	if !nsec.IsInt64() {
+		if nsec.Sign() < 0 {
+			return time.Duration(math.MinInt64)
+		}
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(nsec.Int64())
 }
 
 func getLogger() *log.RelayLogger {
@@ -0,0 +1,116 @@
+package relay
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	tmclient "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	"github.com/hyperledger-labs/yui-relayer/log"
+)
+
+func TestCompareConsensusState(t *testing.T) {
+	blockHash := make([]byte, 32)
+	blockHash[0] = 0x01
+	appHash := make([]byte, 32)
+	appHash[0] = 0x02
+	now := time.Unix(1700000000, 123)
+	header := &tmclient.Header{
+		SignedHeader: &tmproto.SignedHeader{
+			Header: &tmproto.Header{Height: 10, AppHash: appHash, Time: now},
+			Commit: &tmproto.Commit{Height: 10, BlockID: tmproto.BlockID{Hash: blockHash}},
+		},
+	}
+
+	cases := []struct {
+		name string
+		cs   ConsensusState
+		ok   bool
+	}{
+		{"match", ConsensusState{BlockHash: blockHash, AppHash: appHash, Timestamp: uint64(now.UnixNano())}, true},
+		{"block hash", ConsensusState{BlockHash: appHash, AppHash: appHash, Timestamp: uint64(now.UnixNano())}, false},
+		{"app hash", ConsensusState{BlockHash: blockHash, AppHash: blockHash, Timestamp: uint64(now.UnixNano())}, false},
+		{"timestamp", ConsensusState{BlockHash: blockHash, AppHash: appHash, Timestamp: uint64(now.UnixNano()) + 1}, false},
+	}
+	for _, c := range cases {
+		err := compareConsensusState(&c.cs, header)
+		if c.ok && err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+		} else if !c.ok && !errors.Is(err, ErrConsensusStateMismatch) {
+			t.Errorf("%s: expected ErrConsensusStateMismatch, got %v", c.name, err)
+		}
+	}
+}
+
+func TestDurationMulByFraction(t *testing.T) {
+	cases := []struct {
+		name     string
+		d        time.Duration
+		f        Fraction
+		expected time.Duration
+	}{
+		{"two thirds", 3 * time.Hour, Fraction{Numerator: 2, Denominator: 3}, 2 * time.Hour},
+		{"rounded down", 10 * time.Nanosecond, Fraction{Numerator: 1, Denominator: 3}, 3 * time.Nanosecond},
+		{"zero", 3 * time.Hour, Fraction{Numerator: 0, Denominator: 3}, 0},
+		{"one", 3 * time.Hour, Fraction{Numerator: 3, Denominator: 3}, 3 * time.Hour},
+		// the product of 14 days and 2^40 nanoseconds overflows int64
+		{"large numerator", 14 * 24 * time.Hour, Fraction{Numerator: 1 << 40, Denominator: 1 << 41}, 7 * 24 * time.Hour},
+		{"max duration", time.Duration(math.MaxInt64), Fraction{Numerator: 2, Denominator: 3}, time.Duration(math.MaxInt64 / 3 * 2)},
+		{"max uint64", time.Hour, Fraction{Numerator: math.MaxUint64, Denominator: math.MaxUint64}, time.Hour},
+		{"saturated", time.Duration(math.MaxInt64), Fraction{Numerator: 3, Denominator: 2}, time.Duration(math.MaxInt64)},
+		{"negative saturated", time.Duration(math.MinInt64), Fraction{Numerator: 3, Denominator: 2}, time.Duration(math.MinInt64)},
+	}
+	for _, c := range cases {
+		if actual := durationMulByFraction(c.d, c.f); actual != c.expected {
+			t.Errorf("%s: expected=%v actual=%v", c.name, c.expected, actual)
+		}
+	}
+}
+
+func TestIsRefreshRequired(t *testing.T) {
+	if err := log.InitLogger("ERROR", "text", "stderr"); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1700000000, 0)
+	height := clienttypes.NewHeight(REVISION_NUMBER, 10)
+
+	cases := []struct {
+		name    string
+		rate    *Fraction
+		elapsed time.Duration
+		ok      bool
+	}{
+		// the default threshold is 2/3 of the trusting period (3h)
+		{"not elapsed", nil, 0, false},
+		{"before default threshold", nil, 2*time.Hour - time.Nanosecond, false},
+		{"at default threshold", nil, 2 * time.Hour, false},
+		{"after default threshold", nil, 2*time.Hour + time.Nanosecond, true},
+		{"at custom threshold", &Fraction{Numerator: 1, Denominator: 3}, time.Hour, false},
+		{"after custom threshold", &Fraction{Numerator: 1, Denominator: 3}, time.Hour + time.Nanosecond, true},
+		{"zero rate", &Fraction{Numerator: 0, Denominator: 1}, time.Nanosecond, true},
+		{"full rate", &Fraction{Numerator: 1, Denominator: 1}, 3 * time.Hour, false},
+		{"large fraction", &Fraction{Numerator: math.MaxUint64 / 2, Denominator: math.MaxUint64}, 90*time.Minute - time.Nanosecond, false},
+		{"consensus state in the future", nil, -time.Hour, false},
+	}
+	for _, c := range cases {
+		pr := &Prover{config: ProverConfig{TrustingPeriod: "3h", RefreshThresholdRate: c.rate}}
+		cons := &ConsensusState{Timestamp: uint64(now.Add(-c.elapsed).UnixNano())}
+		if actual := pr.isRefreshRequired(height, cons, 3*time.Hour, now); actual != c.ok {
+			t.Errorf("%s: expected=%v actual=%v", c.name, c.ok, actual)
+		}
+	}
+
+	// the threshold follows the trusting period of the client state, not the one in the config
+	pr := &Prover{config: ProverConfig{TrustingPeriod: "3h"}}
+	cons := &ConsensusState{Timestamp: uint64(now.Add(-time.Hour).UnixNano())}
+	if !pr.isRefreshRequired(height, cons, time.Hour, now) {
+		t.Error("expected a refresh for the shorter trusting period of the client state")
+	}
+	cons = &ConsensusState{Timestamp: uint64(now.Add(-3 * time.Hour).UnixNano())}
+	if pr.isRefreshRequired(height, cons, 6*time.Hour, now) {
+		t.Error("unexpected refresh for the longer trusting period of the client state")
+	}
+}
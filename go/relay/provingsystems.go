@@ -0,0 +1,7 @@
+package relay
+
+// The proving systems register themselves to the zkp registry on import
+import (
+	_ "github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp/groth16"
+	_ "github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp/mock"
+)
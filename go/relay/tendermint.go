@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
@@ -22,12 +21,6 @@ import (
 	tmclient "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
 )
 
-// NOTE: currently we are discarding the very noisy light client logs
-// it would be nice if we could add a setting the chain or otherwise
-// that allowed users to enable light client logging. (maybe as a hidden prop
-// on the Chain struct that users could pass in the config??)
-var logger = light.Logger(log.NewTMLogger(log.NewSyncWriter(ioutil.Discard)))
-
 // ErrLightNotInitialized returns the canonical error for a an uninitialized light client
 var ErrLightNotInitialized = errors.New("light client is not initialized")
 
@@ -42,18 +35,59 @@ var (
 // this should be call for all other light client usage
 func (pr *Prover) LightClient(db dbm.DB) (*light.Client, error) {
 	prov := pr.LightHTTP()
+	witnesses, err := pr.LightWitnesses(prov)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := pr.lightOptions()
+	if err != nil {
+		return nil, err
+	}
 	return light.NewClientFromTrustedStore(
 		pr.chain.ChainID(),
 		pr.getTrustingPeriod(),
 		prov,
-		// TODO: provide actual witnesses!
-		// NOTE: This requires adding them to the chain config
-		[]lightp.Provider{prov},
+		witnesses,
 		dbs.New(db, ""),
-		logger,
+		opts...,
 	)
 }
 
+// lightOptions returns the light client options built from the prover config
+func (pr *Prover) lightOptions() ([]light.Option, error) {
+	var opts []light.Option
+	switch pr.config.VerificationMode {
+	case VerificationModeSequential:
+		opts = append(opts, light.SequentialVerification())
+	default:
+		opts = append(opts, light.SkippingVerification(pr.config.GetTrustLevel()))
+	}
+	if pr.config.MaxClockDrift != "" {
+		d, err := time.ParseDuration(pr.config.MaxClockDrift)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, light.MaxClockDrift(d))
+	}
+	if pr.config.MaxBlockLag != "" {
+		d, err := time.ParseDuration(pr.config.MaxBlockLag)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, light.MaxBlockLag(d))
+	}
+	if level := pr.config.GetLightLogLevel(); level == "none" {
+		opts = append(opts, light.Logger(log.NewNopLogger()))
+	} else {
+		allowLevel, err := log.AllowLevel(level)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, light.Logger(log.NewFilter(newLightLogger(), allowLevel)))
+	}
+	return opts, nil
+}
+
 // LightHTTP returns the http client for light clients
 func (pr *Prover) LightHTTP() lightp.Provider {
 	cl, err := lighthttp.New(pr.chain.ChainID(), pr.chain.Config().RpcAddr)
@@ -63,6 +97,23 @@ func (pr *Prover) LightHTTP() lightp.Provider {
 	return cl
 }
 
+// LightWitnesses returns the http clients for the witnesses configured by `witness_addrs`.
+// If no witness is configured, the primary provider is used as its own witness.
+func (pr *Prover) LightWitnesses(primary lightp.Provider) ([]lightp.Provider, error) {
+	if len(pr.config.WitnessAddrs) == 0 {
+		return []lightp.Provider{primary}, nil
+	}
+	witnesses := make([]lightp.Provider, 0, len(pr.config.WitnessAddrs))
+	for _, addr := range pr.config.WitnessAddrs {
+		cl, err := lighthttp.New(pr.chain.ChainID(), addr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create a witness provider: addr=%v %w", addr, err)
+		}
+		witnesses = append(witnesses, cl)
+	}
+	return witnesses, nil
+}
+
 func (pr *Prover) NewLightDB() (db *dbm.GoLevelDB, df func(), err error) {
 	c := pr.chain
 	if err := retry.Do(func() error {
@@ -94,16 +145,22 @@ func (pr *Prover) DeleteLightDB() error {
 // database.
 func (pr *Prover) LightClientWithTrust(db dbm.DB, to light.TrustOptions) (*light.Client, error) {
 	prov := pr.LightHTTP()
+	witnesses, err := pr.LightWitnesses(prov)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := pr.lightOptions()
+	if err != nil {
+		return nil, err
+	}
 	return light.NewClient(
 		context.Background(),
 		pr.chain.ChainID(),
 		to,
 		prov,
-		// TODO: provide actual witnesses!
-		// NOTE: This requires adding them to the chain config
-		[]lightp.Provider{prov},
+		witnesses,
 		dbs.New(db, ""),
-		logger)
+		opts...)
 }
 
 // LightClientWithoutTrust querys the latest header from the chain and initializes a new light client
@@ -141,6 +198,14 @@ func (pr *Prover) LightClientWithoutTrust(db dbm.DB) (*light.Client, error) {
 	if err != nil {
 		return nil, err
 	}
+	witnesses, err := pr.LightWitnesses(prov)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := pr.lightOptions()
+	if err != nil {
+		return nil, err
+	}
 	return light.NewClient(
 		context.Background(),
 		pr.chain.ChainID(),
@@ -150,11 +215,9 @@ func (pr *Prover) LightClientWithoutTrust(db dbm.DB) (*light.Client, error) {
 			Hash:   lb.SignedHeader.Hash(),
 		},
 		prov,
-		// TODO: provide actual witnesses!
-		// NOTE: This requires adding them to the chain config
-		[]lightp.Provider{prov},
+		witnesses,
 		dbs.New(db, ""),
-		logger)
+		opts...)
 }
 
 // GetLatestLightHeader returns the header to be used for client creation
@@ -228,17 +291,27 @@ func (pr *Prover) UpdateLightClient(height int64) (*tmclient.Header, error) {
 
 	var sh *types.LightBlock
 	if height == 0 {
-		if sh, err = client.Update(context.Background(), time.Now()); err != nil {
+		// the latest block of the primary is verified at its height instead of by `client.Update`,
+		// so that a divergence is reported at the height of the conflicting headers
+		latest, err := client.Primary().LightBlock(context.Background(), 0)
+		if err != nil {
 			return nil, lightError(err)
-		} else if sh == nil {
-			sh, err = client.TrustedLightBlock(0)
-			if err != nil {
+		}
+		lastTrustedHeight, err := client.LastTrustedHeight()
+		if err != nil {
+			return nil, lightError(err)
+		}
+		if latest.Height <= lastTrustedHeight {
+			if sh, err = client.TrustedLightBlock(0); err != nil {
 				return nil, lightError(err)
 			}
+		} else {
+			height = latest.Height
 		}
-	} else {
+	}
+	if sh == nil {
 		if sh, err = client.VerifyLightBlockAtHeight(context.Background(), height, time.Now()); err != nil {
-			return nil, lightError(err)
+			return nil, lightError(divergenceError(height, err))
 		}
 	}
 
@@ -273,3 +346,11 @@ func (pr *Prover) getTrustingPeriod() time.Duration {
 }
 
 func lightError(err error) error { return fmt.Errorf("light client: %w", err) }
+
+// divergenceError converts the attack detected by the light client into DivergenceError
+func divergenceError(height int64, err error) error {
+	if errors.Is(err, light.ErrLightClientAttack) {
+		return &DivergenceError{Height: height, Reason: err}
+	}
+	return err
+}
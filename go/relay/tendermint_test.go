@@ -0,0 +1,165 @@
+package relay
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/light"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpcserver "github.com/cometbft/cometbft/rpc/jsonrpc/server"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+	"github.com/hyperledger-labs/yui-relayer/chains/tendermint"
+)
+
+const testChainID = "testchain-1"
+
+// newTestLightBlocks returns the light blocks at heights 1 to `latest` signed by a single validator from `genesisTime`.
+// The app hash of the blocks from `forkHeight` differs from the one of the canonical chain if `forkHeight` is positive.
+func newTestLightBlocks(t *testing.T, pv types.PrivValidator, genesisTime time.Time, latest, forkHeight int64) map[int64]*types.LightBlock {
+	pubKey, err := pv.GetPubKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	valSet := types.NewValidatorSet([]*types.Validator{types.NewValidator(pubKey, 10)})
+
+	blocks := make(map[int64]*types.LightBlock)
+	var lastBlockID types.BlockID
+	for height := int64(1); height <= latest; height++ {
+		appHash := []byte("app_hash")
+		if forkHeight > 0 && height >= forkHeight {
+			appHash = []byte("fork_app_hash")
+		}
+		header := &types.Header{
+			Version:            cmtversion.Consensus{Block: version.BlockProtocol},
+			ChainID:            testChainID,
+			Height:             height,
+			Time:               genesisTime.Add(time.Duration(height) * time.Second),
+			LastBlockID:        lastBlockID,
+			ValidatorsHash:     valSet.Hash(),
+			NextValidatorsHash: valSet.Hash(),
+			AppHash:            appHash,
+			ProposerAddress:    valSet.Proposer.Address,
+		}
+		blockID := types.BlockID{
+			Hash:          header.Hash(),
+			PartSetHeader: types.PartSetHeader{Total: 1, Hash: header.Hash()},
+		}
+		voteSet := types.NewVoteSet(testChainID, height, 0, cmtproto.PrecommitType, valSet)
+		commit, err := types.MakeCommit(blockID, height, 0, voteSet, []types.PrivValidator{pv}, header.Time)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blocks[height] = &types.LightBlock{
+			SignedHeader: &types.SignedHeader{Header: header, Commit: commit},
+			ValidatorSet: valSet,
+		}
+		lastBlockID = blockID
+	}
+	return blocks
+}
+
+// newTestLightRPCServer serves the `commit` and `validators` RPC methods used by the light client providers
+func newTestLightRPCServer(t *testing.T, blocks map[int64]*types.LightBlock) *httptest.Server {
+	latest := int64(len(blocks))
+	lightBlock := func(heightPtr *int64) (*types.LightBlock, error) {
+		height := latest
+		if heightPtr != nil && *heightPtr > 0 {
+			height = *heightPtr
+		}
+		lb, ok := blocks[height]
+		if !ok {
+			return nil, fmt.Errorf("height %d must be less than or equal to the current blockchain height %d", height, latest)
+		}
+		return lb, nil
+	}
+	mux := http.NewServeMux()
+	rpcserver.RegisterRPCFuncs(mux, map[string]*rpcserver.RPCFunc{
+		"commit": rpcserver.NewRPCFunc(func(_ *rpctypes.Context, heightPtr *int64) (*ctypes.ResultCommit, error) {
+			lb, err := lightBlock(heightPtr)
+			if err != nil {
+				return nil, err
+			}
+			return ctypes.NewResultCommit(lb.Header, lb.Commit, true), nil
+		}, "height"),
+		"validators": rpcserver.NewRPCFunc(func(_ *rpctypes.Context, heightPtr *int64, _, _ *int) (*ctypes.ResultValidators, error) {
+			lb, err := lightBlock(heightPtr)
+			if err != nil {
+				return nil, err
+			}
+			return &ctypes.ResultValidators{
+				BlockHeight: lb.Height,
+				Validators:  lb.ValidatorSet.Validators,
+				Count:       len(lb.ValidatorSet.Validators),
+				Total:       len(lb.ValidatorSet.Validators),
+			}, nil
+		}, "height,page,per_page"),
+	}, log.NewNopLogger())
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// newTestLightProver returns the prover whose light client trusts the block at height 1 of the primary
+func newTestLightProver(t *testing.T, primary, witness *httptest.Server, trusted *types.LightBlock) *Prover {
+	chain, err := tendermint.ChainConfig{ChainId: testChainID, RpcAddr: primary.URL}.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := chain.(*tendermint.Chain)
+	c.HomePath = t.TempDir()
+	pr := NewProver(c, ProverConfig{TrustingPeriod: "336h", WitnessAddrs: []string{witness.URL}})
+
+	db, df, err := pr.NewLightDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df()
+	if _, err := pr.LightClientWithTrust(db, light.TrustOptions{
+		Period: 336 * time.Hour,
+		Height: trusted.Height,
+		Hash:   trusted.Hash(),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return pr
+}
+
+func TestGetLatestFinalizedHeaderDivergence(t *testing.T) {
+	pv := types.NewMockPV()
+	genesisTime := time.Now().Add(-time.Hour).UTC()
+	blocks := newTestLightBlocks(t, pv, genesisTime, 5, 0)
+	primary := newTestLightRPCServer(t, blocks)
+
+	// the witness agrees with the primary
+	pr := newTestLightProver(t, primary, newTestLightRPCServer(t, newTestLightBlocks(t, pv, genesisTime, 5, 0)), blocks[1])
+	h, err := pr.GetLatestFinalizedHeader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.GetHeight().GetRevisionHeight() != 5 {
+		t.Errorf("unexpected height: %v", h.GetHeight())
+	}
+
+	// the witness forks from height 3, so the latest header of the primary conflicts with the one of the witness
+	pr = newTestLightProver(t, primary, newTestLightRPCServer(t, newTestLightBlocks(t, pv, genesisTime, 5, 3)), blocks[1])
+	_, err = pr.GetLatestFinalizedHeader()
+	var divergence *DivergenceError
+	if !errors.As(err, &divergence) {
+		t.Fatalf("expected a divergence error: %v", err)
+	}
+	if divergence.Height != 5 {
+		t.Errorf("unexpected divergence height: %v", divergence.Height)
+	}
+	if !errors.Is(err, light.ErrLightClientAttack) {
+		t.Errorf("unexpected reason: %v", divergence.Reason)
+	}
+}
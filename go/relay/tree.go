@@ -1,6 +1,7 @@
 package relay
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 
@@ -12,12 +13,17 @@ import (
 	ics23 "github.com/cosmos/ics23/go"
 )
 
-var IBCStoreKey = "ibc"
+// DefaultIBCStoreKey is the store key and the commitment prefix of the vanilla cosmos-sdk IBC store
+const DefaultIBCStoreKey = "ibc"
 
-func getSimpleTreeProof(h *cometbfttypes.Header) [6][]byte {
-	leaves := headerToLeaves(h)
-	if leaves == nil {
-		panic("leaves is nil")
+// getSimpleTreeProof returns the sibling hashes to prove `Time` and `AppHash` against the header hash
+func getSimpleTreeProof(h *cometbfttypes.Header) ([6][]byte, error) {
+	leaves, err := headerToLeaves(h)
+	if err != nil {
+		return [6][]byte{}, err
+	}
+	if err := verifyHeaderLeaves(h, leaves); err != nil {
+		return [6][]byte{}, err
 	}
 	idx7 := innerHash(leaves[0], leaves[1])
 	idx17 := leaves[2]
@@ -28,27 +34,45 @@ func getSimpleTreeProof(h *cometbfttypes.Header) [6][]byte {
 		innerHash(leaves[4], leaves[5]),
 		innerHash(leaves[6], leaves[7]),
 	)
-	return [6][]byte{idx4, idx6, idx7, idx11, idx17, idx26}
+	return [6][]byte{idx4, idx6, idx7, idx11, idx17, idx26}, nil
+}
+
+func headerToLeaves(h *cometbfttypes.Header) ([][]byte, error) {
+	fields, err := headerToFields(h)
+	if err != nil {
+		return nil, err
+	}
+	// hash each field
+	leaves := make([][]byte, len(fields))
+	for i, field := range fields {
+		leaves[i] = leafHash(field)
+	}
+	return leaves, nil
 }
 
-func headerToLeaves(h *cometbfttypes.Header) [][]byte {
-	if h == nil || len(h.ValidatorsHash) == 0 {
-		return nil
+// headerToFields returns the encoded fields of the header in the order of `Header.Hash`
+func headerToFields(h *cometbfttypes.Header) ([][]byte, error) {
+	if h == nil {
+		return nil, ErrNilHeader
+	}
+	// `Header.Hash` returns nil if the validators hash is empty
+	if len(h.ValidatorsHash) == 0 {
+		return nil, &HeaderFieldError{Field: "ValidatorsHash", Err: errors.New("empty")}
 	}
 	hbz, err := h.Version.Marshal()
 	if err != nil {
-		return nil
+		return nil, &HeaderFieldError{Field: "Version", Err: err}
 	}
 
 	pbt, err := gogotypes.StdTimeMarshal(h.Time)
 	if err != nil {
-		return nil
+		return nil, &HeaderFieldError{Field: "Time", Err: err}
 	}
 
 	pbbi := h.LastBlockID.ToProto()
 	bzbi, err := pbbi.Marshal()
 	if err != nil {
-		return nil
+		return nil, &HeaderFieldError{Field: "LastBlockID", Err: err}
 	}
 
 	fields := [][]byte{
@@ -67,11 +91,7 @@ func headerToLeaves(h *cometbfttypes.Header) [][]byte {
 		cdcEncode(h.EvidenceHash),
 		cdcEncode(h.ProposerAddress),
 	}
-	// hash each field
-	for i, field := range fields {
-		fields[i] = leafHash(field)
-	}
-	return fields
+	return fields, nil
 }
 
 var (
@@ -155,7 +175,7 @@ func isEmpty(o interface{}) bool {
 	}
 }
 
-func verifyAndConvertToExistenceProof(root [32]byte, path string, value []byte, merkleProof *commitmenttypes.MerkleProof) ([]byte, error) {
+func verifyAndConvertToExistenceProof(layout StoreLayout, root [32]byte, path string, value []byte, merkleProof *commitmenttypes.MerkleProof) ([]byte, error) {
 	if len(merkleProof.Proofs) != 2 {
 		return nil, fmt.Errorf("invalid merkle proof: %v", merkleProof)
 	}
@@ -168,53 +188,144 @@ func verifyAndConvertToExistenceProof(root [32]byte, path string, value []byte,
 		return nil, fmt.Errorf("invalid merkle proof[1]: %v", merkleProof)
 	}
 
-	err := merkleProof.VerifyMembership(commitmenttypes.GetSDKSpecs(), commitmenttypes.NewMerkleRoot(root[:]), commitmenttypes.NewMerklePath(IBCStoreKey, path), value)
+	err := merkleProof.VerifyMembership(layout.ICS23Specs(), commitmenttypes.NewMerkleRoot(root[:]), commitmenttypes.NewMerklePath(layout.CommitmentPrefix, path), value)
 	if err != nil {
 		return nil, err
 	}
 
-	p0, err := buildExistenceProofIAVL(pe0.Exist)
+	var ep []ExistenceProof
+	ep = append(ep, *buildExistenceProof(layout.Specs[0].Spec, pe0.Exist), *buildExistenceProof(layout.Specs[1].Spec, pe1.Exist))
+
+	bz, err := EthABIEncodeExistenceProofs(ep)
 	if err != nil {
 		return nil, err
 	}
-	p1, err := buildExistenceProofSimpleTree(pe1.Exist)
+	// ensure that the contract accepts the proof
+	if err := VerifyEthABIExistenceProofs(layout.ProofSpecs(), root, []byte(layout.CommitmentPrefix), []byte(path), value, bz); err != nil {
+		return nil, fmt.Errorf("the converted existence proof is rejected by the verifier: %w", err)
+	}
+	return bz, nil
+}
+
+// proof specs, which must match `TendermintTreeVerifier.ProofSpec`
+const (
+	SimpleTree uint8 = 1
+	IAVLSpec         = 2
+	SMTSpec          = 3
+)
+
+// TreeSpec is a pair of the ics23 proof spec and the corresponding `TendermintTreeVerifier.ProofSpec`
+type TreeSpec struct {
+	Spec  uint8
+	ICS23 *ics23.ProofSpec
+}
+
+// treeSpecs are the tree specs which can be configured with `proof_specs`
+var treeSpecs = map[string]TreeSpec{
+	"iavl":       {Spec: IAVLSpec, ICS23: ics23.IavlSpec},
+	"tendermint": {Spec: SimpleTree, ICS23: ics23.TendermintSpec},
+	"smt":        {Spec: SMTSpec, ICS23: ics23.SmtSpec},
+}
+
+// GetTreeSpec returns the tree spec of `name`
+func GetTreeSpec(name string) (TreeSpec, error) {
+	spec, ok := treeSpecs[name]
+	if !ok {
+		return TreeSpec{}, fmt.Errorf("unknown proof spec: %v", name)
+	}
+	return spec, nil
+}
+
+// StoreLayout describes how the IBC store is committed to the app hash
+type StoreLayout struct {
+	// StoreKey is the name of the store that ABCI queries are sent to
+	StoreKey string
+	// CommitmentPrefix is the key of the IBC store root in the app hash tree
+	CommitmentPrefix string
+	// Specs are the tree specs of the IBC store and the app hash tree
+	Specs [2]TreeSpec
+}
+
+// DefaultStoreLayout is the layout of the vanilla cosmos-sdk IBC store
+var DefaultStoreLayout = StoreLayout{
+	StoreKey:         DefaultIBCStoreKey,
+	CommitmentPrefix: DefaultIBCStoreKey,
+	Specs:            [2]TreeSpec{treeSpecs["iavl"], treeSpecs["tendermint"]},
+}
+
+// ProofSpecs returns the specs as `TendermintTreeVerifier.ProofSpec`
+func (l StoreLayout) ProofSpecs() [2]uint8 {
+	return [2]uint8{l.Specs[0].Spec, l.Specs[1].Spec}
+}
+
+// ICS23Specs returns the ics23 specs to verify the merkle proofs
+func (l StoreLayout) ICS23Specs() []*ics23.ProofSpec {
+	return []*ics23.ProofSpec{l.Specs[0].ICS23, l.Specs[1].ICS23}
+}
+
+func verifyAndConvertToNonExistenceProof(layout StoreLayout, root [32]byte, path string, merkleProof *commitmenttypes.MerkleProof) ([]byte, error) {
+	if len(merkleProof.Proofs) != 2 {
+		return nil, fmt.Errorf("invalid merkle proof: %v", merkleProof)
+	}
+	// `TendermintTreeVerifier.verifyNonMembershipIAVL` only supports the IAVL spec
+	if layout.Specs[0].Spec != IAVLSpec {
+		return nil, fmt.Errorf("non-membership proofs are not supported for the proof spec: %v", layout.Specs[0].Spec)
+	}
+	pn0, ok := merkleProof.Proofs[0].Proof.(*ics23.CommitmentProof_Nonexist)
+	if !ok {
+		return nil, fmt.Errorf("invalid merkle proof[0]: %v", merkleProof)
+	}
+	pe1, ok := merkleProof.Proofs[1].Proof.(*ics23.CommitmentProof_Exist)
+	if !ok {
+		return nil, fmt.Errorf("invalid merkle proof[1]: %v", merkleProof)
+	}
+
+	err := merkleProof.VerifyNonMembership(layout.ICS23Specs(), commitmenttypes.NewMerkleRoot(root[:]), commitmenttypes.NewMerklePath(layout.CommitmentPrefix, path))
 	if err != nil {
 		return nil, err
 	}
 
-	var ep []ExistenceProof
-	ep = append(ep, *p0, *p1)
+	p0, err := buildNonExistenceProofIAVL(pn0.Nonexist)
+	if err != nil {
+		return nil, err
+	}
+	p1 := buildExistenceProof(layout.Specs[1].Spec, pe1.Exist)
 
-	return EthABIEncodeExistenceProofs(ep)
+	bz, err := EthABIEncodeNonMembershipProof(NonMembershipProof{Proof0: *p0, Proof1: *p1})
+	if err != nil {
+		return nil, err
+	}
+	// ensure that the contract accepts the proof
+	if err := VerifyEthABINonMembershipProof(layout.ProofSpecs(), root, []byte(layout.CommitmentPrefix), []byte(path), bz); err != nil {
+		return nil, fmt.Errorf("the converted non-membership proof is rejected by the verifier: %w", err)
+	}
+	return bz, nil
 }
 
-const (
-	SimpleTree uint8 = 0
-	IAVLSpec         = 1
-)
-
-func buildExistenceProofIAVL(proof *ics23.ExistenceProof) (*ExistenceProof, error) {
-	ep := ExistenceProof{
-		Spec:   IAVLSpec,
-		Prefix: proof.Leaf.Prefix,
-		Key:    proof.Key,
-		Value:  proof.Value,
+// buildNonExistenceProofIAVL converts the IAVL non-existence proof.
+// A missing neighbor is encoded as an empty IAVL existence proof because the verifier requires both neighbors to have the IAVL spec.
+func buildNonExistenceProofIAVL(proof *ics23.NonExistenceProof) (*NonExistenceProof, error) {
+	nep := NonExistenceProof{
+		Key:   proof.Key,
+		Left:  ExistenceProof{Spec: IAVLSpec},
+		Right: ExistenceProof{Spec: IAVLSpec},
 	}
-	for _, step := range proof.Path {
-		ep.Path = append(ep.Path, struct {
-			Prefix []byte `json:"prefix"`
-			Suffix []byte `json:"suffix"`
-		}{
-			Prefix: step.Prefix,
-			Suffix: step.Suffix,
-		})
+	if proof.Left == nil && proof.Right == nil {
+		return nil, fmt.Errorf("both neighbors of the non-existence proof are nil")
+	}
+	if proof.Left != nil {
+		nep.Left = *buildExistenceProof(IAVLSpec, proof.Left)
+	}
+	if proof.Right != nil {
+		nep.Right = *buildExistenceProof(IAVLSpec, proof.Right)
 	}
-	return &ep, nil
+	return &nep, nil
 }
 
-func buildExistenceProofSimpleTree(proof *ics23.ExistenceProof) (*ExistenceProof, error) {
+// buildExistenceProof converts the ics23 existence proof into the proof of `spec`
+func buildExistenceProof(spec uint8, proof *ics23.ExistenceProof) *ExistenceProof {
 	ep := ExistenceProof{
-		Spec:   SimpleTree,
+		Spec:   spec,
 		Prefix: proof.Leaf.Prefix,
 		Key:    proof.Key,
 		Value:  proof.Value,
@@ -228,5 +339,5 @@ func buildExistenceProofSimpleTree(proof *ics23.ExistenceProof) (*ExistenceProof
 			Suffix: step.Suffix,
 		})
 	}
-	return &ep, nil
+	return &ep
 }
@@ -0,0 +1,155 @@
+package relay
+
+import (
+	"errors"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/iavl"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	ics23 "github.com/cosmos/ics23/go"
+)
+
+// newTestMerkleProof builds the ibc store from `kvs` and returns the app hash and the merkle proof of `key`
+func newTestMerkleProof(t testing.TB, kvs map[string][]byte, key string) ([32]byte, *commitmenttypes.MerkleProof) {
+	tree, err := iavl.NewMutableTree(dbm.NewMemDB(), 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range kvs {
+		if _, err := tree.Set([]byte(k), v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	storeRoot, version, err := tree.SaveVersion()
+	if err != nil {
+		t.Fatal(err)
+	}
+	itree, err := tree.GetImmutable(version)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var iavlProof interface{ Marshal() ([]byte, error) }
+	if _, ok := kvs[key]; ok {
+		iavlProof, err = itree.GetMembershipProof([]byte(key))
+	} else {
+		iavlProof, err = itree.GetNonMembershipProof([]byte(key))
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ci := storetypes.CommitInfo{
+		Version: version,
+		StoreInfos: []storetypes.StoreInfo{
+			{Name: DefaultIBCStoreKey, CommitId: storetypes.CommitID{Version: version, Hash: storeRoot}},
+			{Name: "bank", CommitId: storetypes.CommitID{Version: version, Hash: make([]byte, 32)}},
+		},
+	}
+	op, err := storetypes.CommitmentOpDecoder(ci.ProofOp(DefaultIBCStoreKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return [32]byte(ci.Hash()), &commitmenttypes.MerkleProof{
+		Proofs: []*ics23.CommitmentProof{convertICS23Proof(t, iavlProof), convertICS23Proof(t, op.(storetypes.CommitmentOp).Proof)},
+	}
+}
+
+// convertICS23Proof converts the proof of another module path of ics23 that iavl and the sdk store depend on
+func convertICS23Proof(t testing.TB, proof interface{ Marshal() ([]byte, error) }) *ics23.CommitmentProof {
+	bz, err := proof.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var p ics23.CommitmentProof
+	if err := p.Unmarshal(bz); err != nil {
+		t.Fatal(err)
+	}
+	return &p
+}
+
+var testIBCStore = map[string][]byte{
+	"clients/07-tendermint-0/clientState":                       []byte("client"),
+	"connections/connection-0":                                  []byte("connection"),
+	"commitments/ports/transfer/channels/channel-0/sequences/1": []byte("commitment"),
+	"receipts/ports/transfer/channels/channel-0/sequences/1":    []byte{0x01},
+}
+
+func TestVerifyAndConvertToExistenceProof(t *testing.T) {
+	path := "connections/connection-0"
+	root, merkleProof := newTestMerkleProof(t, testIBCStore, path)
+	bz, err := verifyAndConvertToExistenceProof(DefaultStoreLayout, root, path, testIBCStore[path], merkleProof)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proofs, err := EthABIDecodeExistenceProofs(bz)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proofs[0].Spec != IAVLSpec || string(proofs[0].Key) != path {
+		t.Fatalf("unexpected IAVL proof: %v", proofs[0])
+	}
+	if proofs[1].Spec != SimpleTree || string(proofs[1].Key) != DefaultIBCStoreKey {
+		t.Fatalf("unexpected simple tree proof: %v", proofs[1])
+	}
+	if _, err := verifyAndConvertToExistenceProof(DefaultStoreLayout, root, path, []byte("other"), merkleProof); err == nil {
+		t.Fatal("expected an error for a wrong value")
+	}
+	layout := DefaultStoreLayout
+	layout.CommitmentPrefix = "bank"
+	if _, err := verifyAndConvertToExistenceProof(layout, root, path, testIBCStore[path], merkleProof); err == nil {
+		t.Fatal("expected an error for a wrong commitment prefix")
+	}
+}
+
+func TestVerifyAndConvertToNonExistenceProof(t *testing.T) {
+	for _, path := range []string{
+		// between two keys
+		"commitments/ports/transfer/channels/channel-0/sequences/2",
+		// left most
+		"acks/ports/transfer/channels/channel-0/sequences/1",
+		// right most
+		"receipts/ports/transfer/channels/channel-0/sequences/2",
+	} {
+		root, merkleProof := newTestMerkleProof(t, testIBCStore, path)
+		bz, err := verifyAndConvertToNonExistenceProof(DefaultStoreLayout, root, path, merkleProof)
+		if err != nil {
+			t.Fatalf("%v: %v", path, err)
+		}
+		proof, err := EthABIDecodeNonMembershipProof(bz)
+		if err != nil {
+			t.Fatalf("%v: %v", path, err)
+		}
+		if string(proof.Proof0.Key) != path {
+			t.Fatalf("%v: unexpected key: %s", path, proof.Proof0.Key)
+		}
+		if proof.Proof0.Left.Spec != IAVLSpec || proof.Proof0.Right.Spec != IAVLSpec {
+			t.Fatalf("%v: unexpected specs: %v %v", path, proof.Proof0.Left.Spec, proof.Proof0.Right.Spec)
+		}
+		if proof.Proof1.Spec != SimpleTree || string(proof.Proof1.Key) != DefaultIBCStoreKey {
+			t.Fatalf("%v: unexpected simple tree proof: %v", path, proof.Proof1)
+		}
+	}
+
+	path := "connections/connection-0"
+	root, merkleProof := newTestMerkleProof(t, testIBCStore, path)
+	if _, err := verifyAndConvertToNonExistenceProof(DefaultStoreLayout, root, path, merkleProof); err == nil {
+		t.Fatal("expected an error for an existing key")
+	}
+}
+
+func TestGetSimpleTreeProof(t *testing.T) {
+	if _, err := getSimpleTreeProof(newTestHeader()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := getSimpleTreeProof(nil); !errors.Is(err, ErrNilHeader) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	h := newTestHeader()
+	h.ValidatorsHash = nil
+	var fieldErr *HeaderFieldError
+	if _, err := getSimpleTreeProof(h); !errors.As(err, &fieldErr) || fieldErr.Field != "ValidatorsHash" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
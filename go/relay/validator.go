@@ -0,0 +1,89 @@
+package relay
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"time"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/datachainlab/tendermint-zk-ibc/go/inputs"
+)
+
+// UpdateStateValidator validates UpdateStateMessage before submission in the same way as `TendermintZKLightClient.updateState`
+type UpdateStateValidator struct {
+	StepVerifierDigest []byte
+	SkipVerifierDigest []byte
+	TrustingPeriod     time.Duration
+	// VerifyZKProof verifies the zk proof with the public inputs if it is not nil
+	VerifyZKProof ZKProofVerifier
+}
+
+// NewUpdateStateValidator returns the validator with the digests and the trusting period of `config`.
+// `verifyZKProof` is the verifier of the configured verifying key, or nil if it is not configured.
+func NewUpdateStateValidator(config ProverConfig, verifyZKProof ZKProofVerifier) UpdateStateValidator {
+	return UpdateStateValidator{
+		StepVerifierDigest: config.GetStepVerifierDigest(),
+		SkipVerifierDigest: config.GetSkipVerifierDigest(),
+		TrustingPeriod:     config.GetTrustingPeriod(),
+		VerifyZKProof:      verifyZKProof,
+	}
+}
+
+// Validate checks that `msg` is accepted by the client whose consensus state at the trusted height is `trusted`
+func (v UpdateStateValidator) Validate(msg *UpdateStateMessage, trusted *ConsensusState, now time.Time) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	if trusted == nil || len(trusted.BlockHash) == 0 {
+		return fmt.Errorf("consensus state not found: trusted_height=%d", msg.TrustedHeight)
+	}
+	if expiry := time.Unix(0, int64(trusted.Timestamp)).Add(v.TrustingPeriod); now.After(expiry) {
+		return fmt.Errorf("the trusted consensus state has expired: trusted_height=%d expiry=%v now=%v", msg.TrustedHeight, expiry, now)
+	}
+	if msg.Timestamp <= trusted.Timestamp {
+		return fmt.Errorf("timestamp must be greater than the trusted one: timestamp=%d trusted_timestamp=%d", msg.Timestamp, trusted.Timestamp)
+	}
+
+	expected := inputs.Compute(v.StepVerifierDigest, v.SkipVerifierDigest, msg.TrustedHeight, trusted.BlockHash, msg.UntrustedHeight, msg.UntrustedBlockHash)
+	var input [3]*big.Int
+	for i := range expected {
+		input[i] = new(big.Int).SetBytes(msg.Input[i])
+		if e := new(big.Int).SetBytes(expected[i][:]); input[i].Cmp(e) != 0 {
+			return fmt.Errorf("unexpected input[%d]: expected=0x%x actual=0x%x", i, e, input[i])
+		}
+	}
+
+	root, err := headerHashFromSimpleTreeProof(msg.Timestamp, msg.AppHash, msg.SimpleTreeProof)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(root, msg.UntrustedBlockHash) {
+		return fmt.Errorf("invalid simple tree proof: expected=%X actual=%X", msg.UntrustedBlockHash, root)
+	}
+
+	if v.VerifyZKProof != nil {
+		if err := v.VerifyZKProof(input, msg.ZkProof); err != nil {
+			return fmt.Errorf("invalid zk proof: %w", err)
+		}
+	}
+	return nil
+}
+
+// headerHashFromSimpleTreeProof mirrors `TendermintHeader.merkleRoot` and returns the header hash computed from `Time`, `AppHash` and the sibling hashes
+func headerHashFromSimpleTreeProof(timestamp uint64, appHash []byte, proof [][]byte) ([]byte, error) {
+	if len(proof) != 6 {
+		return nil, fmt.Errorf("invalid simple tree proof length: %d", len(proof))
+	}
+	pbt, err := gogotypes.StdTimeMarshal(time.Unix(0, int64(timestamp)))
+	if err != nil {
+		return nil, err
+	}
+	timestampLeaf := leafHash(pbt)
+	appHashLeaf := leafHash(cdcEncode(cmtbytes.HexBytes(appHash)))
+	return innerHash(
+		innerHash(innerHash(proof[2], innerHash(proof[4], timestampLeaf)), proof[0]),
+		innerHash(innerHash(proof[3], innerHash(appHashLeaf, proof[5])), proof[1]),
+	), nil
+}
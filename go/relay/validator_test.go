@@ -0,0 +1,99 @@
+package relay
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/datachainlab/tendermint-zk-ibc/go/inputs"
+)
+
+func TestUpdateStateValidator(t *testing.T) {
+	v := UpdateStateValidator{
+		StepVerifierDigest: bytes.Repeat([]byte{0x01}, 32),
+		SkipVerifierDigest: bytes.Repeat([]byte{0x02}, 32),
+		TrustingPeriod:     time.Hour,
+	}
+	h := newTestHeader()
+	trusted := &ConsensusState{
+		BlockHash: bytes.Repeat([]byte{0xaa}, 32),
+		AppHash:   bytes.Repeat([]byte{0xbb}, 32),
+		Timestamp: uint64(h.Time.Add(-time.Minute).UnixNano()),
+	}
+	now := h.Time.Add(time.Minute)
+
+	newMsg := func(trustedHeight uint64) *UpdateStateMessage {
+		simpleTreeProof, err := getSimpleTreeProof(h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		input := inputs.Compute(v.StepVerifierDigest, v.SkipVerifierDigest, trustedHeight, trusted.BlockHash, uint64(h.Height), h.Hash())
+		return &UpdateStateMessage{
+			TrustedHeight:      trustedHeight,
+			UntrustedHeight:    uint64(h.Height),
+			UntrustedBlockHash: h.Hash(),
+			Timestamp:          uint64(h.Time.UnixNano()),
+			AppHash:            h.AppHash,
+			SimpleTreeProof:    simpleTreeProof[:],
+			Input:              [][]byte{input[0][:], input[1][:], input[2][:]},
+			ZkProof:            []byte("mock"),
+		}
+	}
+
+	cases := []struct {
+		name   string
+		modify func(v *UpdateStateValidator, m *UpdateStateMessage) time.Time
+		ok     bool
+	}{
+		{"step", func(v *UpdateStateValidator, m *UpdateStateMessage) time.Time { return now }, true},
+		{"skip", func(v *UpdateStateValidator, m *UpdateStateMessage) time.Time {
+			*m = *newMsg(90)
+			return now
+		}, true},
+		{"expired", func(v *UpdateStateValidator, m *UpdateStateMessage) time.Time { return now.Add(time.Hour) }, false},
+		// the contract rejects the message only after the trusting period has passed
+		{"at expiry", func(v *UpdateStateValidator, m *UpdateStateMessage) time.Time {
+			return time.Unix(0, int64(trusted.Timestamp)).Add(v.TrustingPeriod)
+		}, true},
+		{"just after expiry", func(v *UpdateStateValidator, m *UpdateStateMessage) time.Time {
+			return time.Unix(0, int64(trusted.Timestamp)).Add(v.TrustingPeriod + time.Nanosecond)
+		}, false},
+		{"old timestamp", func(v *UpdateStateValidator, m *UpdateStateMessage) time.Time {
+			m.Timestamp = trusted.Timestamp
+			return now
+		}, false},
+		{"wrong verifier digest", func(v *UpdateStateValidator, m *UpdateStateMessage) time.Time {
+			v.StepVerifierDigest = v.SkipVerifierDigest
+			return now
+		}, false},
+		{"wrong trusted height", func(v *UpdateStateValidator, m *UpdateStateMessage) time.Time {
+			m.TrustedHeight--
+			return now
+		}, false},
+		{"wrong app hash", func(v *UpdateStateValidator, m *UpdateStateMessage) time.Time {
+			m.AppHash = trusted.AppHash
+			return now
+		}, false},
+		{"wrong simple tree proof", func(v *UpdateStateValidator, m *UpdateStateMessage) time.Time {
+			m.SimpleTreeProof[0], m.SimpleTreeProof[1] = m.SimpleTreeProof[1], m.SimpleTreeProof[0]
+			return now
+		}, false},
+		{"invalid zk proof", func(v *UpdateStateValidator, m *UpdateStateMessage) time.Time {
+			v.VerifyZKProof = func(input [3]*big.Int, proof []byte) error { return errors.New("invalid proof") }
+			return now
+		}, false},
+		{"valid zk proof", func(v *UpdateStateValidator, m *UpdateStateMessage) time.Time {
+			v.VerifyZKProof = func(input [3]*big.Int, proof []byte) error { return nil }
+			return now
+		}, true},
+	}
+	for _, c := range cases {
+		v, msg := v, newMsg(uint64(h.Height-1))
+		now := c.modify(&v, msg)
+		if err := v.Validate(msg, trusted, now); c.ok != (err == nil) {
+			t.Errorf("%s: unexpected result: %v", c.name, err)
+		}
+	}
+}
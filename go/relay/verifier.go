@@ -0,0 +1,329 @@
+package relay
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+)
+
+// VerifyEthABIExistenceProofs verifies the ABI-encoded existence proofs in the same way as `TendermintZKLightClient.verifyMembership`.
+// `specs` are the expected specs of the IBC store and the app hash tree, which the proofs must have.
+func VerifyEthABIExistenceProofs(specs [2]uint8, appHash [32]byte, prefix []byte, path []byte, value []byte, proof []byte) error {
+	proofs, err := EthABIDecodeExistenceProofs(proof)
+	if err != nil {
+		return fmt.Errorf("failed to decode existence proofs: %w", err)
+	}
+	for i := range specs {
+		if proofs[i].Spec != specs[i] {
+			return fmt.Errorf("unexpected proof spec: index=%d expected=%v actual=%v", i, specs[i], proofs[i].Spec)
+		}
+	}
+	ibcCommitmentRoot, err := verifyMembershipSpec(specs[0], proofs[0].Prefix, path, value, proofs[0].Path)
+	if err != nil {
+		return err
+	}
+	root, err := verifyMembershipSpec(specs[1], proofs[1].Prefix, prefix, ibcCommitmentRoot[:], proofs[1].Path)
+	if err != nil {
+		return err
+	}
+	if root != appHash {
+		return fmt.Errorf("app hash mismatch: expected=%X actual=%X", appHash, root)
+	}
+	return nil
+}
+
+// VerifyEthABINonMembershipProof verifies the ABI-encoded non-membership proof in the same way as `TendermintZKLightClient.verifyNonMembership`.
+// `specs` are the expected specs of the IBC store and the app hash tree, and only the IAVL spec is supported for the IBC store.
+func VerifyEthABINonMembershipProof(specs [2]uint8, appHash [32]byte, prefix []byte, path []byte, proof []byte) error {
+	if specs[0] != IAVLSpec {
+		return fmt.Errorf("non-membership proofs are not supported for the proof spec: %v", specs[0])
+	}
+	p, err := EthABIDecodeNonMembershipProof(proof)
+	if err != nil {
+		return fmt.Errorf("failed to decode non-membership proof: %w", err)
+	}
+	if p.Proof1.Spec != specs[1] {
+		return fmt.Errorf("unexpected proof spec: index=1 expected=%v actual=%v", specs[1], p.Proof1.Spec)
+	}
+	if !bytes.Equal(p.Proof0.Key, path) {
+		return fmt.Errorf("path mismatch: expected=%X actual=%X", path, p.Proof0.Key)
+	}
+	if !bytes.Equal(p.Proof1.Key, prefix) {
+		return fmt.Errorf("prefix mismatch: expected=%X actual=%X", prefix, p.Proof1.Key)
+	}
+	ibcCommitmentRoot, err := verifyNonMembershipIAVL(p.Proof0)
+	if err != nil {
+		return err
+	}
+	root, err := verifyMembershipSpec(specs[1], p.Proof1.Prefix, prefix, ibcCommitmentRoot[:], p.Proof1.Path)
+	if err != nil {
+		return err
+	}
+	if root != appHash {
+		return fmt.Errorf("app hash mismatch: expected=%X actual=%X", appHash, root)
+	}
+	return nil
+}
+
+// verifyMembership mirrors `TendermintTreeVerifier.verifyMembership` and returns the root
+func verifyMembership(proof ExistenceProof) ([32]byte, error) {
+	return verifyMembershipSpec(proof.Spec, proof.Prefix, proof.Key, proof.Value, proof.Path)
+}
+
+// verifyMembershipSpec verifies the proof according to `spec` and returns the root
+func verifyMembershipSpec(spec uint8, prefix, key, value []byte, path []struct {
+	Prefix []byte `json:"prefix"`
+	Suffix []byte `json:"suffix"`
+}) ([32]byte, error) {
+	switch spec {
+	case SimpleTree:
+		return verifyMembershipTendermintSpec(prefix, key, value, path)
+	case IAVLSpec:
+		return verifyMembershipIAVLSpec(prefix, key, value, path)
+	case SMTSpec:
+		return verifyMembershipSMTSpec(prefix, key, value, path)
+	default:
+		return [32]byte{}, fmt.Errorf("unsupported proof spec: %v", spec)
+	}
+}
+
+// verifyMembershipTendermintSpec mirrors `TendermintTreeVerifier.verifyMembershipTendermintSpec` and returns the root
+func verifyMembershipTendermintSpec(prefix, key, value []byte, path []struct {
+	Prefix []byte `json:"prefix"`
+	Suffix []byte `json:"suffix"`
+}) ([32]byte, error) {
+	if len(prefix) != 1 || prefix[0] != 0 {
+		return [32]byte{}, fmt.Errorf("invalid leaf prefix: %X", prefix)
+	}
+	h := leafHashOp(prefix, key, value)
+	for i, step := range path {
+		if len(step.Prefix) < 1 || len(step.Prefix) > 33 {
+			return [32]byte{}, fmt.Errorf("invalid inner prefix length: index=%d length=%d", i, len(step.Prefix))
+		}
+		if len(step.Suffix)%32 != 0 {
+			return [32]byte{}, fmt.Errorf("invalid inner suffix length: index=%d length=%d", i, len(step.Suffix))
+		}
+		h = innerHashOp(step.Prefix, h, step.Suffix)
+	}
+	return h, nil
+}
+
+// verifyMembershipIAVLSpec mirrors `TendermintTreeVerifier.verifyMembershipIAVLSpec` and returns the root
+func verifyMembershipIAVLSpec(prefix, key, value []byte, path []struct {
+	Prefix []byte `json:"prefix"`
+	Suffix []byte `json:"suffix"`
+}) ([32]byte, error) {
+	if len(prefix) == 0 || prefix[0] != 0 {
+		return [32]byte{}, fmt.Errorf("invalid leaf prefix: %X", prefix)
+	}
+	if err := validateIAVLOps(prefix, 0); err != nil {
+		return [32]byte{}, err
+	}
+	h := leafHashOp(prefix, key, value)
+	for i, step := range path {
+		if len(step.Prefix) < 4 || len(step.Prefix) > 45 {
+			return [32]byte{}, fmt.Errorf("invalid inner prefix length: index=%d length=%d", i, len(step.Prefix))
+		}
+		if len(step.Suffix)%33 != 0 {
+			return [32]byte{}, fmt.Errorf("invalid inner suffix length: index=%d length=%d", i, len(step.Suffix))
+		}
+		if err := validateIAVLOps(step.Prefix, i+1); err != nil {
+			return [32]byte{}, err
+		}
+		h = innerHashOp(step.Prefix, h, step.Suffix)
+	}
+	return h, nil
+}
+
+// verifyMembershipSMTSpec mirrors `TendermintTreeVerifier.verifyMembershipSMTSpec` and returns the root
+func verifyMembershipSMTSpec(prefix, key, value []byte, path []struct {
+	Prefix []byte `json:"prefix"`
+	Suffix []byte `json:"suffix"`
+}) ([32]byte, error) {
+	if len(prefix) != 1 || prefix[0] != 0 {
+		return [32]byte{}, fmt.Errorf("invalid leaf prefix: %X", prefix)
+	}
+	keyHash, valueHash := sha256.Sum256(key), sha256.Sum256(value)
+	h := sha256.Sum256(append(append(append([]byte{}, prefix...), keyHash[:]...), valueHash[:]...))
+	for i, step := range path {
+		if len(step.Prefix) < 1 || len(step.Prefix) > 33 {
+			return [32]byte{}, fmt.Errorf("invalid inner prefix length: index=%d length=%d", i, len(step.Prefix))
+		}
+		if len(step.Suffix)%32 != 0 {
+			return [32]byte{}, fmt.Errorf("invalid inner suffix length: index=%d length=%d", i, len(step.Suffix))
+		}
+		h = innerHashOp(step.Prefix, h, step.Suffix)
+	}
+	return h, nil
+}
+
+// innerOp is an element of `ExistenceProof.Path`
+type innerOp = struct {
+	Prefix []byte `json:"prefix"`
+	Suffix []byte `json:"suffix"`
+}
+
+// verifyNonMembershipIAVL mirrors `TendermintTreeVerifier.verifyNonMembershipIAVL` and returns the root
+func verifyNonMembershipIAVL(proof NonExistenceProof) ([32]byte, error) {
+	if proof.Left.Spec != IAVLSpec || proof.Right.Spec != IAVLSpec {
+		return [32]byte{}, fmt.Errorf("unsupported proof specs: left=%v right=%v", proof.Left.Spec, proof.Right.Spec)
+	}
+	isLeftNil, isRightNil := len(proof.Left.Key) == 0, len(proof.Right.Key) == 0
+	if isLeftNil && isRightNil {
+		return [32]byte{}, fmt.Errorf("both proofs are nil")
+	}
+	if !isLeftNil && bytes.Compare(proof.Left.Key, proof.Key) >= 0 {
+		return [32]byte{}, fmt.Errorf("left key must be less than the key: left=%X key=%X", proof.Left.Key, proof.Key)
+	}
+	if !isRightNil && bytes.Compare(proof.Key, proof.Right.Key) >= 0 {
+		return [32]byte{}, fmt.Errorf("right key must be greater than the key: right=%X key=%X", proof.Right.Key, proof.Key)
+	}
+
+	var root [32]byte
+	if !isLeftNil {
+		r, err := verifyMembershipIAVLSpec(proof.Left.Prefix, proof.Left.Key, proof.Left.Value, proof.Left.Path)
+		if err != nil {
+			return [32]byte{}, fmt.Errorf("invalid left proof: %w", err)
+		}
+		root = r
+	}
+	if !isRightNil {
+		r, err := verifyMembershipIAVLSpec(proof.Right.Prefix, proof.Right.Key, proof.Right.Value, proof.Right.Path)
+		if err != nil {
+			return [32]byte{}, fmt.Errorf("invalid right proof: %w", err)
+		}
+		if !isLeftNil && root != r {
+			return [32]byte{}, fmt.Errorf("root mismatch: left=%X right=%X", root, r)
+		}
+		root = r
+	}
+
+	switch {
+	case isLeftNil:
+		if !isLeftMostIAVL(proof.Right.Path) {
+			return [32]byte{}, fmt.Errorf("right proof is not the left most")
+		}
+	case isRightNil:
+		if !isRightMostIAVL(proof.Left.Path) {
+			return [32]byte{}, fmt.Errorf("left proof is not the right most")
+		}
+	default:
+		if !isLeftNeighborIAVL(proof.Left.Path, proof.Right.Path) {
+			return [32]byte{}, fmt.Errorf("left and right proofs are not neighbors")
+		}
+	}
+	return root, nil
+}
+
+// isLeftMostIAVL mirrors `TendermintTreeVerifier.isLeftMostIAVL`
+func isLeftMostIAVL(path []innerOp) bool {
+	minPrefix, maxPrefix, suffix := getPaddingIAVL(0)
+	for _, op := range path {
+		if !hasPadding(op, minPrefix, maxPrefix, suffix) {
+			return false
+		}
+	}
+	return true
+}
+
+// isRightMostIAVL mirrors `TendermintTreeVerifier.isRightMostIAVL`
+func isRightMostIAVL(path []innerOp) bool {
+	minPrefix, maxPrefix, suffix := getPaddingIAVL(1)
+	for _, op := range path {
+		if !hasPadding(op, minPrefix, maxPrefix, suffix) {
+			return false
+		}
+	}
+	return true
+}
+
+// isLeftNeighborIAVL mirrors `TendermintTreeVerifier.isLeftNeighborIAVL`
+func isLeftNeighborIAVL(left, right []innerOp) bool {
+	leftIdx, rightIdx := len(left)-1, len(right)-1
+	for leftIdx >= 0 && rightIdx >= 0 &&
+		bytes.Equal(left[leftIdx].Prefix, right[rightIdx].Prefix) &&
+		bytes.Equal(left[leftIdx].Suffix, right[rightIdx].Suffix) {
+		leftIdx--
+		rightIdx--
+	}
+	if leftIdx < 0 || rightIdx < 0 {
+		return false
+	}
+	leftOrder, ok := orderFromPaddingIAVL(left[leftIdx])
+	if !ok {
+		return false
+	}
+	rightOrder, ok := orderFromPaddingIAVL(right[rightIdx])
+	if !ok || rightOrder != leftOrder+1 {
+		return false
+	}
+	return isRightMostIAVL(left[:leftIdx]) && isLeftMostIAVL(right[:rightIdx])
+}
+
+// getPaddingIAVL mirrors `TendermintTreeVerifier.getPaddingIAVL` for the branch 0 or 1
+func getPaddingIAVL(branch int) (minPrefix, maxPrefix, suffix int) {
+	prefix := branch * 33
+	return prefix + 4, prefix + 12, (1 - branch) * 33
+}
+
+func hasPadding(op innerOp, minPrefix, maxPrefix, suffix int) bool {
+	return len(op.Prefix) >= minPrefix && len(op.Prefix) <= maxPrefix && len(op.Suffix) == suffix
+}
+
+// orderFromPaddingIAVL mirrors `TendermintTreeVerifier.orderFromPaddingIAVL`
+func orderFromPaddingIAVL(op innerOp) (int, bool) {
+	for b := 0; b < 2; b++ {
+		minPrefix, maxPrefix, suffix := getPaddingIAVL(b)
+		if hasPadding(op, minPrefix, maxPrefix, suffix) {
+			return b, true
+		}
+	}
+	return 0, false
+}
+
+// validateIAVLOps mirrors `TendermintTreeVerifier.validateIAVLOps`
+func validateIAVLOps(prefix []byte, b int) error {
+	r := bytes.NewReader(prefix)
+	var values [3]uint64
+	for i := range values {
+		v, err := binary.ReadUvarint(r)
+		if err != nil {
+			return fmt.Errorf("invalid IAVL prefix: %X %w", prefix, err)
+		}
+		values[i] = v
+	}
+	if values[0] < uint64(b) {
+		return fmt.Errorf("invalid IAVL op: height=%d b=%d", values[0], b)
+	}
+	remaining := r.Len()
+	if b == 0 {
+		if remaining != 0 {
+			return fmt.Errorf("invalid IAVL op: leaf prefix has %d extra bytes", remaining)
+		}
+	} else if remaining != 1 && remaining != 34 {
+		return fmt.Errorf("invalid IAVL op: inner prefix has %d extra bytes", remaining)
+	}
+	return nil
+}
+
+// returns sha256(prefix || varint(len(key)) || key || varint(32) || sha256(value))
+func leafHashOp(prefix, key, value []byte) [32]byte {
+	valueHash := sha256.Sum256(value)
+	var bz []byte
+	bz = append(bz, prefix...)
+	bz = binary.AppendUvarint(bz, uint64(len(key)))
+	bz = append(bz, key...)
+	bz = binary.AppendUvarint(bz, uint64(len(valueHash)))
+	bz = append(bz, valueHash[:]...)
+	return sha256.Sum256(bz)
+}
+
+// returns sha256(prefix || child || suffix)
+func innerHashOp(prefix []byte, child [32]byte, suffix []byte) [32]byte {
+	var bz []byte
+	bz = append(bz, prefix...)
+	bz = append(bz, child[:]...)
+	bz = append(bz, suffix...)
+	return sha256.Sum256(bz)
+}
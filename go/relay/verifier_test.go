@@ -0,0 +1,316 @@
+package relay
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"testing"
+
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	ics23 "github.com/cosmos/ics23/go"
+)
+
+// newTestEthABIExistenceProofs converts the merkle proof into the ABI payload without verifying it
+func newTestEthABIExistenceProofs(t testing.TB, merkleProof *commitmenttypes.MerkleProof) []byte {
+	p0 := buildExistenceProof(IAVLSpec, merkleProof.Proofs[0].GetExist())
+	p1 := buildExistenceProof(SimpleTree, merkleProof.Proofs[1].GetExist())
+	bz, err := EthABIEncodeExistenceProofs([]ExistenceProof{*p0, *p1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bz
+}
+
+func TestVerifyEthABIExistenceProofs(t *testing.T) {
+	path := "commitments/ports/transfer/channels/channel-0/sequences/1"
+	value := testIBCStore[path]
+	root, merkleProof := newTestMerkleProof(t, testIBCStore, path)
+	proof := newTestEthABIExistenceProofs(t, merkleProof)
+
+	if err := VerifyEthABIExistenceProofs(DefaultStoreLayout.ProofSpecs(), root, []byte(DefaultIBCStoreKey), []byte(path), value, proof); err != nil {
+		t.Fatal(err)
+	}
+
+	otherRoot := root
+	otherRoot[0] ^= 1
+	for name, tc := range map[string]struct {
+		root   [32]byte
+		prefix string
+		path   string
+		value  []byte
+	}{
+		"wrong root":   {otherRoot, DefaultIBCStoreKey, path, value},
+		"wrong prefix": {root, "bank", path, value},
+		"wrong path":   {root, DefaultIBCStoreKey, "connections/connection-0", value},
+		"wrong value":  {root, DefaultIBCStoreKey, path, []byte("other")},
+	} {
+		if err := VerifyEthABIExistenceProofs(DefaultStoreLayout.ProofSpecs(), tc.root, []byte(tc.prefix), []byte(tc.path), tc.value, proof); err == nil {
+			t.Fatalf("%v: expected an error", name)
+		}
+	}
+}
+
+func TestVerifyEthABIExistenceProofsSpecs(t *testing.T) {
+	path := "commitments/ports/transfer/channels/channel-0/sequences/1"
+	value := testIBCStore[path]
+	root, merkleProof := newTestMerkleProof(t, testIBCStore, path)
+	p0 := buildExistenceProof(IAVLSpec, merkleProof.Proofs[0].GetExist())
+	p1 := buildExistenceProof(SimpleTree, merkleProof.Proofs[1].GetExist())
+
+	// a proof of a sparse merkle tree committed to the app hash with a simple tree
+	smtKey, smtValue := []byte("clients/07-tendermint-0/clientState"), []byte("client")
+	smt := ExistenceProof{Spec: SMTSpec, Prefix: []byte{0}, Key: smtKey, Value: smtValue, Path: []innerOp{
+		{Prefix: []byte{1}, Suffix: bytes.Repeat([]byte{0xaa}, 32)},
+		{Prefix: append([]byte{1}, bytes.Repeat([]byte{0xbb}, 32)...)},
+	}}
+	smtRoot, err := verifyMembership(smt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	smtApp := ExistenceProof{Spec: SimpleTree, Prefix: []byte{0}, Key: []byte(DefaultIBCStoreKey), Value: smtRoot[:], Path: []innerOp{
+		{Prefix: []byte{1}, Suffix: bytes.Repeat([]byte{0xcc}, 32)},
+	}}
+	smtAppHash, err := verifyMembership(smtApp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	smtSpecs := [2]uint8{SMTSpec, SimpleTree}
+
+	cases := []struct {
+		name    string
+		specs   [2]uint8
+		appHash [32]byte
+		path    []byte
+		value   []byte
+		proofs  []ExistenceProof
+		ok      bool
+	}{
+		{"iavl", DefaultStoreLayout.ProofSpecs(), root, []byte(path), value, []ExistenceProof{*p0, *p1}, true},
+		{"smt", smtSpecs, smtAppHash, smtKey, smtValue, []ExistenceProof{smt, smtApp}, true},
+		{"smt proofs for iavl", DefaultStoreLayout.ProofSpecs(), smtAppHash, smtKey, smtValue, []ExistenceProof{smt, smtApp}, false},
+		{"iavl proofs for smt", smtSpecs, root, []byte(path), value, []ExistenceProof{*p0, *p1}, false},
+		{"spec of the app hash tree", DefaultStoreLayout.ProofSpecs(), root, []byte(path), value, []ExistenceProof{*p0, func() ExistenceProof { p := *p1; p.Spec = SMTSpec; return p }()}, false},
+	}
+	for _, c := range cases {
+		bz, err := EthABIEncodeExistenceProofs(c.proofs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := VerifyEthABIExistenceProofs(c.specs, c.appHash, []byte(DefaultIBCStoreKey), c.path, c.value, bz); c.ok != (err == nil) {
+			t.Errorf("%s: unexpected result: %v", c.name, err)
+		}
+	}
+
+	// non-membership proofs are only supported for the IAVL spec
+	if err := VerifyEthABINonMembershipProof(smtSpecs, smtAppHash, []byte(DefaultIBCStoreKey), smtKey, nil); err == nil {
+		t.Error("expected an error for the smt spec")
+	}
+}
+
+func TestVerifyMembershipIAVLSpecInvalidOps(t *testing.T) {
+	path := "connections/connection-0"
+	_, merkleProof := newTestMerkleProof(t, testIBCStore, path)
+	p := buildExistenceProof(IAVLSpec, merkleProof.Proofs[0].GetExist())
+	if _, err := verifyMembership(*p); err != nil {
+		t.Fatal(err)
+	}
+
+	// the leaf prefix must not have trailing bytes
+	if _, err := verifyMembershipIAVLSpec(append(bytes.Clone(p.Prefix), 0), p.Key, p.Value, p.Path); err == nil {
+		t.Fatal("expected an error for an invalid leaf prefix")
+	}
+	// the height of the inner node must be greater than or equal to its depth
+	p.Path[0].Prefix = append([]byte{0}, p.Path[0].Prefix[1:]...)
+	if _, err := verifyMembershipIAVLSpec(p.Prefix, p.Key, p.Value, p.Path); err == nil {
+		t.Fatal("expected an error for an invalid inner prefix")
+	}
+	if _, err := verifyMembership(ExistenceProof{Spec: 0}); err == nil {
+		t.Fatal("expected an error for an unsupported spec")
+	}
+}
+
+func TestVerifyMembershipSMTSpec(t *testing.T) {
+	key, value := []byte("clients/07-tendermint-0/clientState"), []byte("client")
+	proof := &ics23.ExistenceProof{
+		Key:   key,
+		Value: value,
+		Leaf:  ics23.SmtSpec.LeafSpec,
+		Path: []*ics23.InnerOp{
+			{Hash: ics23.HashOp_SHA256, Prefix: []byte{1}, Suffix: bytes.Repeat([]byte{0xaa}, 32)},
+			{Hash: ics23.HashOp_SHA256, Prefix: append([]byte{1}, bytes.Repeat([]byte{0xbb}, 32)...)},
+		},
+	}
+	expected, err := proof.Calculate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := proof.Verify(ics23.SmtSpec, expected, key, value); err != nil {
+		t.Fatal(err)
+	}
+	p := buildExistenceProof(SMTSpec, proof)
+	actual, err := verifyMembership(*p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(actual[:], expected) {
+		t.Fatalf("root mismatch: expected=%X actual=%X", expected, actual)
+	}
+	if actual, err := verifyMembershipSMTSpec(p.Prefix, key, []byte("other"), p.Path); err != nil {
+		t.Fatal(err)
+	} else if bytes.Equal(actual[:], expected) {
+		t.Fatal("expected a different root for a wrong value")
+	}
+}
+
+func TestVerifyEthABINonMembershipProof(t *testing.T) {
+	path := "commitments/ports/transfer/channels/channel-0/sequences/2"
+	root, merkleProof := newTestMerkleProof(t, testIBCStore, path)
+	p0, err := buildNonExistenceProofIAVL(merkleProof.Proofs[0].GetNonexist())
+	if err != nil {
+		t.Fatal(err)
+	}
+	p1 := buildExistenceProof(SimpleTree, merkleProof.Proofs[1].GetExist())
+
+	cases := []struct {
+		name   string
+		modify func(p *NonMembershipProof)
+		ok     bool
+	}{
+		{"valid", func(p *NonMembershipProof) {}, true},
+		{"swapped neighbors", func(p *NonMembershipProof) { p.Proof0.Left, p.Proof0.Right = p.Proof0.Right, p.Proof0.Left }, false},
+		{"missing left", func(p *NonMembershipProof) { p.Proof0.Left = ExistenceProof{Spec: IAVLSpec} }, false},
+		{"missing right", func(p *NonMembershipProof) { p.Proof0.Right = ExistenceProof{Spec: IAVLSpec} }, false},
+		{"both missing", func(p *NonMembershipProof) {
+			p.Proof0.Left, p.Proof0.Right = ExistenceProof{Spec: IAVLSpec}, ExistenceProof{Spec: IAVLSpec}
+		}, false},
+		{"simple tree spec", func(p *NonMembershipProof) { p.Proof0.Left.Spec = SimpleTree }, false},
+		{"unexpected app hash tree spec", func(p *NonMembershipProof) { p.Proof1.Spec = SMTSpec }, false},
+	}
+	for _, c := range cases {
+		p := NonMembershipProof{Proof0: *p0, Proof1: *p1}
+		c.modify(&p)
+		bz, err := EthABIEncodeNonMembershipProof(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := VerifyEthABINonMembershipProof(DefaultStoreLayout.ProofSpecs(), root, []byte(DefaultIBCStoreKey), []byte(path), bz); c.ok != (err == nil) {
+			t.Errorf("%s: unexpected result: %v", c.name, err)
+		}
+	}
+
+	// the key must be between the neighbors
+	bz, err := EthABIEncodeNonMembershipProof(NonMembershipProof{Proof0: *p0, Proof1: *p1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"acks/ports/transfer/channels/channel-0/sequences/1", "connections/connection-0"} {
+		p := NonMembershipProof{Proof0: *p0, Proof1: *p1}
+		p.Proof0.Key = []byte(key)
+		bz, err := EthABIEncodeNonMembershipProof(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := VerifyEthABINonMembershipProof(DefaultStoreLayout.ProofSpecs(), root, []byte(DefaultIBCStoreKey), []byte(key), bz); err == nil {
+			t.Errorf("%s: expected an error for a key out of range", key)
+		}
+	}
+	if err := VerifyEthABINonMembershipProof(DefaultStoreLayout.ProofSpecs(), root, []byte(DefaultIBCStoreKey), []byte("connections/connection-0"), bz); err == nil {
+		t.Error("expected an error for a path mismatch")
+	}
+}
+
+// ics23VerifyEthABIExistenceProofs verifies the ABI-encoded existence proofs with ics23 and the configured `specs`
+func ics23VerifyEthABIExistenceProofs(specs [2]uint8, appHash [32]byte, prefix, path, value, proof []byte) error {
+	proofs, err := EthABIDecodeExistenceProofs(proof)
+	if err != nil {
+		return err
+	}
+	key := path
+	for i, p := range proofs {
+		if p.Spec != specs[i] {
+			return fmt.Errorf("unexpected proof spec: index=%d", i)
+		}
+		var spec *ics23.ProofSpec
+		for _, s := range treeSpecs {
+			if s.Spec == specs[i] {
+				spec = s.ICS23
+			}
+		}
+		if spec == nil {
+			return fmt.Errorf("unsupported proof spec: %v", specs[i])
+		}
+		// ics23 appends to the prefixes, which share the buffer of `proof`
+		leaf := *spec.LeafSpec
+		leaf.Prefix = bytes.Clone(p.Prefix)
+		ep := &ics23.ExistenceProof{Key: key, Value: value, Leaf: &leaf}
+		for _, op := range p.Path {
+			ep.Path = append(ep.Path, &ics23.InnerOp{Hash: spec.InnerSpec.Hash, Prefix: bytes.Clone(op.Prefix), Suffix: bytes.Clone(op.Suffix)})
+		}
+		root, err := ep.Calculate()
+		if err != nil {
+			return err
+		}
+		if err := ep.Verify(spec, root, key, value); err != nil {
+			return err
+		}
+		key, value = prefix, root
+	}
+	if !bytes.Equal(value, appHash[:]) {
+		return fmt.Errorf("app hash mismatch")
+	}
+	return nil
+}
+
+// FuzzVerifyEthABIExistenceProofs uses ics23 as the oracle of the verifier.
+// The encoded proof is corrupted with `mask` at `offset`, and the prefix and the configured specs are fuzzed as well.
+// The verifier may be stricter than ics23 for corrupted proofs, but it must never accept a proof that ics23 rejects.
+func FuzzVerifyEthABIExistenceProofs(f *testing.F) {
+	defaultSpecs := uint16(DefaultStoreLayout.Specs[0].Spec) | uint16(DefaultStoreLayout.Specs[1].Spec)<<8
+	f.Add("connections/connection-1", []byte("connection"), []byte("connection"), DefaultIBCStoreKey, defaultSpecs, uint32(0), []byte{})
+	f.Add("connections/connection-1", []byte("connection"), []byte("other"), DefaultIBCStoreKey, defaultSpecs, uint32(0), []byte{})
+	f.Add("a", []byte{0x01}, []byte{}, DefaultIBCStoreKey, defaultSpecs, uint32(0), []byte{})
+	f.Add("z", bytes.Repeat([]byte{0xff}, 256), bytes.Repeat([]byte{0xff}, 256), DefaultIBCStoreKey, defaultSpecs, uint32(0), []byte{})
+	f.Add("connections/connection-1", []byte("connection"), []byte("connection"), "bank", defaultSpecs, uint32(0), []byte{})
+	f.Add("connections/connection-1", []byte("connection"), []byte("connection"), DefaultIBCStoreKey, uint16(SMTSpec)|uint16(SimpleTree)<<8, uint32(0), []byte{})
+	f.Add("connections/connection-1", []byte("connection"), []byte("connection"), DefaultIBCStoreKey, defaultSpecs, uint32(31), []byte{0x01})
+	f.Add("connections/connection-1", []byte("connection"), []byte("connection"), DefaultIBCStoreKey, defaultSpecs, uint32(200), []byte{0xff, 0xff})
+	f.Add("connections/connection-1", []byte("connection"), []byte("connection"), DefaultIBCStoreKey, defaultSpecs, uint32(1000), []byte{0x80})
+	f.Fuzz(func(t *testing.T, path string, value []byte, target []byte, prefix string, specs uint16, offset uint32, mask []byte) {
+		// ibc-go unescapes the keys of the merkle path
+		if unescaped, err := url.PathUnescape(path); err != nil || unescaped != path || len(path) == 0 || len(value) == 0 {
+			t.Skip()
+		}
+		kvs := map[string][]byte{path: value}
+		for k, v := range testIBCStore {
+			if k != path {
+				kvs[k] = v
+			}
+		}
+		root, merkleProof := newTestMerkleProof(t, kvs, path)
+		proof := newTestEthABIExistenceProofs(t, merkleProof)
+		configured := [2]uint8{uint8(specs) % 4, uint8(specs>>8) % 4}
+
+		corrupted := false
+		for i, b := range mask {
+			if j := int(offset%uint32(len(proof))) + i; j < len(proof) && b != 0 {
+				proof[j] ^= b
+				corrupted = true
+			}
+		}
+
+		expected := ics23VerifyEthABIExistenceProofs(configured, root, []byte(prefix), []byte(path), target, proof)
+		actual := VerifyEthABIExistenceProofs(configured, root, []byte(prefix), []byte(path), target, proof)
+		if actual == nil && expected != nil {
+			t.Fatalf("verifier accepts a proof that ics23 rejects: ics23=%v", expected)
+		}
+		if !corrupted && (expected == nil) != (actual == nil) {
+			t.Fatalf("verifier disagrees with ics23: ics23=%v verifier=%v", expected, actual)
+		}
+		if !corrupted && configured == DefaultStoreLayout.ProofSpecs() && prefix == DefaultIBCStoreKey {
+			expected := merkleProof.VerifyMembership(commitmenttypes.GetSDKSpecs(), commitmenttypes.NewMerkleRoot(root[:]), commitmenttypes.NewMerklePath(DefaultIBCStoreKey, path), target)
+			if (expected == nil) != (actual == nil) {
+				t.Fatalf("verifier disagrees with the merkle proof: ics23=%v verifier=%v", expected, actual)
+			}
+		}
+	})
+}
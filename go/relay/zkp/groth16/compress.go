@@ -0,0 +1,271 @@
+package groth16
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const Groth16CompressedProverType = "groth16-compressed"
+
+var (
+	groth16CompressedProofABI, _ = abi.NewType("uint256[4]", "Groth16CompressedProof", nil)
+
+	// constants of `Verifier` in Groth16Verifier.sol
+	modP            = fp.Modulus()
+	expSqrtFp       = new(big.Int).Rsh(new(big.Int).Add(modP, big.NewInt(1)), 2)
+	expInverseFp    = new(big.Int).Sub(modP, big.NewInt(2))
+	fraction1_2Fp   = fpDiv(big.NewInt(1), big.NewInt(2))
+	fraction27_82Fp = fpDiv(big.NewInt(27), big.NewInt(82))
+	fraction3_82Fp  = fpDiv(big.NewInt(3), big.NewInt(82))
+)
+
+// Groth16CompressedProof is the proof compressed by `Verifier.compressProof`: A, the imaginary part of B.x, the real part of B.x with two flag bits and C
+type Groth16CompressedProof [4]*big.Int
+
+var _ zkp.ZKProof = (*Groth16CompressedProof)(nil)
+
+func (p Groth16CompressedProof) EncodeEthABI() []byte {
+	bz, err := EthABIEncodeGroth16CompressedProof(p)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func (p Groth16CompressedProof) ProverType() string {
+	return Groth16CompressedProverType
+}
+
+// Compress compresses the proof in the same way as `Verifier.compressProof`
+func (p Groth16Proof) Compress() (Groth16CompressedProof, error) {
+	var c Groth16CompressedProof
+	var err error
+	if c[0], err = compressG1(p[0], p[1]); err != nil {
+		return c, fmt.Errorf("invalid A: %w", err)
+	}
+	if c[2], c[1], err = compressG2(p[3], p[2], p[5], p[4]); err != nil {
+		return c, fmt.Errorf("invalid B: %w", err)
+	}
+	if c[3], err = compressG1(p[6], p[7]); err != nil {
+		return c, fmt.Errorf("invalid C: %w", err)
+	}
+	return c, nil
+}
+
+// Decompress decompresses the proof in the same way as `Verifier.verifyCompressedProof`
+func (c Groth16CompressedProof) Decompress() (Groth16Proof, error) {
+	var p Groth16Proof
+	var err error
+	if p[0], p[1], err = decompressG1(c[0]); err != nil {
+		return p, fmt.Errorf("invalid A: %w", err)
+	}
+	if p[3], p[2], p[5], p[4], err = decompressG2(c[2], c[1]); err != nil {
+		return p, fmt.Errorf("invalid B: %w", err)
+	}
+	if p[6], p[7], err = decompressG1(c[3]); err != nil {
+		return p, fmt.Errorf("invalid C: %w", err)
+	}
+	return p, nil
+}
+
+func EthABIEncodeGroth16CompressedProof(proof Groth16CompressedProof) ([]byte, error) {
+	packer := abi.Arguments{
+		{Type: groth16CompressedProofABI},
+	}
+	return packer.Pack(proof[:])
+}
+
+func EthABIDecodeGroth16CompressedProof(bz []byte) (Groth16CompressedProof, error) {
+	packer := abi.Arguments{
+		{Type: groth16CompressedProofABI},
+	}
+	v, err := packer.Unpack(bz)
+	if err != nil {
+		return Groth16CompressedProof{}, err
+	}
+	return Groth16CompressedProof(v[0].([4]*big.Int)), nil
+}
+
+func fpMul(a, b *big.Int) *big.Int {
+	return new(big.Int).Mod(new(big.Int).Mul(a, b), modP)
+}
+
+func fpAdd(a, b *big.Int) *big.Int {
+	return new(big.Int).Mod(new(big.Int).Add(a, b), modP)
+}
+
+func fpNegate(a *big.Int) *big.Int {
+	return new(big.Int).Mod(new(big.Int).Sub(modP, new(big.Int).Mod(a, modP)), modP)
+}
+
+func fpDiv(a, b *big.Int) *big.Int {
+	return fpMul(a, new(big.Int).ModInverse(b, modP))
+}
+
+// fpSqrt mirrors `Verifier.sqrt_Fp` that picks the root a^((p+1)/4)
+func fpSqrt(a *big.Int) (*big.Int, error) {
+	x := new(big.Int).Exp(a, expSqrtFp, modP)
+	if fpMul(x, x).Cmp(a) != 0 {
+		return nil, fmt.Errorf("not a square: 0x%x", a)
+	}
+	return x, nil
+}
+
+func fpIsSquare(a *big.Int) bool {
+	_, err := fpSqrt(a)
+	return err == nil
+}
+
+func fpInvert(a *big.Int) (*big.Int, error) {
+	x := new(big.Int).Exp(a, expInverseFp, modP)
+	if fpMul(a, x).Cmp(big.NewInt(1)) != 0 {
+		return nil, fmt.Errorf("no inverse: 0x%x", a)
+	}
+	return x, nil
+}
+
+// fp2Sqrt mirrors `Verifier.sqrt_Fp2`
+func fp2Sqrt(a0, a1 *big.Int, hint bool) (*big.Int, *big.Int, error) {
+	d, err := fpSqrt(fpAdd(fpMul(a0, a0), fpMul(a1, a1)))
+	if err != nil {
+		return nil, nil, err
+	}
+	if hint {
+		d = fpNegate(d)
+	}
+	x0, err := fpSqrt(fpMul(fpAdd(a0, d), fraction1_2Fp))
+	if err != nil {
+		return nil, nil, err
+	}
+	inv, err := fpInvert(fpMul(x0, big.NewInt(2)))
+	if err != nil {
+		return nil, nil, err
+	}
+	x1 := fpMul(a1, inv)
+	if a0.Cmp(fpAdd(fpMul(x0, x0), fpNegate(fpMul(x1, x1)))) != 0 || a1.Cmp(fpMul(big.NewInt(2), fpMul(x0, x1))) != 0 {
+		return nil, nil, fmt.Errorf("no square root in Fp2")
+	}
+	return x0, x1, nil
+}
+
+func inFp(vs ...*big.Int) bool {
+	for _, v := range vs {
+		if v == nil || v.Sign() < 0 || v.Cmp(modP) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// compressG1 mirrors `Verifier.compress_g1`
+func compressG1(x, y *big.Int) (*big.Int, error) {
+	if !inFp(x, y) {
+		return nil, fmt.Errorf("G1 point not in field")
+	}
+	if x.Sign() == 0 && y.Sign() == 0 {
+		return new(big.Int), nil
+	}
+	yPos, err := fpSqrt(fpAdd(fpMul(fpMul(x, x), x), big.NewInt(3)))
+	if err != nil {
+		return nil, fmt.Errorf("G1 point not on curve: %w", err)
+	}
+	c := new(big.Int).Lsh(x, 1)
+	switch {
+	case y.Cmp(yPos) == 0:
+	case y.Cmp(fpNegate(yPos)) == 0:
+		c.SetBit(c, 0, 1)
+	default:
+		return nil, fmt.Errorf("G1 point not on curve")
+	}
+	return c, nil
+}
+
+// decompressG1 mirrors `Verifier.decompress_g1`
+func decompressG1(c *big.Int) (*big.Int, *big.Int, error) {
+	if c == nil || c.Sign() < 0 {
+		return nil, nil, fmt.Errorf("invalid compressed G1 point: %v", c)
+	}
+	if c.Sign() == 0 {
+		return new(big.Int), new(big.Int), nil
+	}
+	x := new(big.Int).Rsh(c, 1)
+	if !inFp(x) {
+		return nil, nil, fmt.Errorf("G1 x coordinate not in field")
+	}
+	y, err := fpSqrt(fpAdd(fpMul(fpMul(x, x), x), big.NewInt(3)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("G1 point not on curve: %w", err)
+	}
+	if c.Bit(0) == 1 {
+		y = fpNegate(y)
+	}
+	return x, y, nil
+}
+
+// g2YSquare returns y^2 = x^3 + 3/(9+i) of the G2 point whose x coordinate is x0 + x1*i
+func g2YSquare(x0, x1 *big.Int) (*big.Int, *big.Int) {
+	n3ab := fpMul(fpMul(x0, x1), new(big.Int).Sub(modP, big.NewInt(3)))
+	a3 := fpMul(fpMul(x0, x0), x0)
+	b3 := fpMul(fpMul(x1, x1), x1)
+	y0 := fpAdd(fraction27_82Fp, fpAdd(a3, fpMul(n3ab, x1)))
+	y1 := fpNegate(fpAdd(fraction3_82Fp, fpAdd(b3, fpMul(n3ab, x0))))
+	return y0, y1
+}
+
+// compressG2 mirrors `Verifier.compress_g2` and returns (x0 with the hint and sign bits, x1)
+func compressG2(x0, x1, y0, y1 *big.Int) (*big.Int, *big.Int, error) {
+	if !inFp(x0, x1, y0, y1) {
+		return nil, nil, fmt.Errorf("G2 point not in field")
+	}
+	if x0.Sign() == 0 && x1.Sign() == 0 && y0.Sign() == 0 && y1.Sign() == 0 {
+		return new(big.Int), new(big.Int), nil
+	}
+	y0Pos, y1Pos := g2YSquare(x0, x1)
+	d, err := fpSqrt(fpAdd(fpMul(y0Pos, y0Pos), fpMul(y1Pos, y1Pos)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("G2 point not on curve: %w", err)
+	}
+	hint := !fpIsSquare(fpMul(fpAdd(y0Pos, d), fraction1_2Fp))
+	if y0Pos, y1Pos, err = fp2Sqrt(y0Pos, y1Pos, hint); err != nil {
+		return nil, nil, fmt.Errorf("G2 point not on curve: %w", err)
+	}
+	c0 := new(big.Int).Lsh(x0, 2)
+	if hint {
+		c0.SetBit(c0, 1, 1)
+	}
+	switch {
+	case y0.Cmp(y0Pos) == 0 && y1.Cmp(y1Pos) == 0:
+	case y0.Cmp(fpNegate(y0Pos)) == 0 && y1.Cmp(fpNegate(y1Pos)) == 0:
+		c0.SetBit(c0, 0, 1)
+	default:
+		return nil, nil, fmt.Errorf("G2 point not on curve")
+	}
+	return c0, new(big.Int).Set(x1), nil
+}
+
+// decompressG2 mirrors `Verifier.decompress_g2` and returns (x0, x1, y0, y1)
+func decompressG2(c0, c1 *big.Int) (*big.Int, *big.Int, *big.Int, *big.Int, error) {
+	if c0 == nil || c1 == nil || c0.Sign() < 0 {
+		return nil, nil, nil, nil, fmt.Errorf("invalid compressed G2 point")
+	}
+	if c0.Sign() == 0 && c1.Sign() == 0 {
+		return new(big.Int), new(big.Int), new(big.Int), new(big.Int), nil
+	}
+	x0, x1 := new(big.Int).Rsh(c0, 2), new(big.Int).Set(c1)
+	if !inFp(x0, x1) {
+		return nil, nil, nil, nil, fmt.Errorf("G2 x coordinate not in field")
+	}
+	y0, y1 := g2YSquare(x0, x1)
+	y0, y1, err := fp2Sqrt(y0, y1, c0.Bit(1) == 1)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("G2 point not on curve: %w", err)
+	}
+	if c0.Bit(0) == 1 {
+		y0, y1 = fpNegate(y0), fpNegate(y1)
+	}
+	return x0, x1, y0, y1, nil
+}
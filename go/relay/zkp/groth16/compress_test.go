@@ -0,0 +1,133 @@
+package groth16
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+func TestCompressConstants(t *testing.T) {
+	cases := []struct {
+		name     string
+		value    *big.Int
+		expected string
+	}{
+		{"FRACTION_1_2_FP", fraction1_2Fp, "0x183227397098d014dc2822db40c0ac2ecbc0b548b438e5469e10460b6c3e7ea4"},
+		{"FRACTION_27_82_FP", fraction27_82Fp, "0x2b149d40ceb8aaae81be18991be06ac3b5b4c5e559dbefa33267e6dc24a138e5"},
+		{"FRACTION_3_82_FP", fraction3_82Fp, "0x2fcd3ac2a640a154eb23960892a85a68f031ca0c8344b23a577dcf1052b9e775"},
+		{"EXP_SQRT_FP", expSqrtFp, "0xc19139cb84c680a6e14116da060561765e05aa45a1c72a34f082305b61f3f52"},
+	}
+	for _, c := range cases {
+		if v := hexutil.EncodeBig(c.value); v != c.expected {
+			t.Errorf("%s: unexpected value: %v", c.name, v)
+		}
+	}
+}
+
+func TestGroth16ProofCompression(t *testing.T) {
+	bz, err := os.ReadFile("../../../../test/data/groth16_proof_01.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var data struct {
+		Proof [8]string `json:"proof"`
+	}
+	if err := json.Unmarshal(bz, &data); err != nil {
+		t.Fatal(err)
+	}
+	var fixture Groth16Proof
+	for i, s := range data.Proof {
+		var ok bool
+		if fixture[i], ok = new(big.Int).SetString(s, 0); !ok {
+			t.Fatalf("invalid proof element: %v", s)
+		}
+	}
+
+	_, _, proof := newTestProof(t)
+	generated, err := EthABIDecodeGroth16Proof(proof)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, p := range []Groth16Proof{fixture, generated} {
+		c, err := p.Compress()
+		if err != nil {
+			t.Fatal(err)
+		}
+		c2, err := EthABIDecodeGroth16CompressedProof(c.EncodeEthABI())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(c, c2) {
+			t.Errorf("unexpected encoding: %v != %v", c, c2)
+		}
+		p2, err := c2.Decompress()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(p, p2) {
+			t.Errorf("unexpected decompression: %v != %v", p, p2)
+		}
+	}
+
+	invalid := generated
+	invalid[1] = new(big.Int).Add(generated[1], big.NewInt(1))
+	if _, err := invalid.Compress(); err == nil {
+		t.Error("expected an error for a point not on the curve")
+	}
+	invalid = generated
+	invalid[4] = new(big.Int).Add(generated[4], big.NewInt(1))
+	if _, err := invalid.Compress(); err == nil {
+		t.Error("expected an error for a G2 point not on the curve")
+	}
+	c, _ := generated.Compress()
+	c[0] = new(big.Int).Lsh(modP, 1)
+	if _, err := c.Decompress(); err == nil {
+		t.Error("expected an error for a non-canonical compressed point")
+	}
+}
+
+func TestGroth16CompressedProvingSystem(t *testing.T) {
+	vk, input, proof := newTestProof(t)
+	p, err := EthABIDecodeGroth16Proof(proof)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ps, err := zkp.Get(Groth16CompressedProverType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zp, err := ps.ParseProof(p.EncodeEthABI())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if zp.ProverType() != Groth16CompressedProverType || len(zp.EncodeEthABI()) != 4*32 {
+		t.Fatalf("unexpected proof: %v", zp)
+	}
+	compressed := zp.EncodeEthABI()
+	if zp2, err := ps.DecodeEthABI(compressed); err != nil || !bytes.Equal(zp2.EncodeEthABI(), compressed) {
+		t.Fatalf("unexpected decoding: %v %v", zp2, err)
+	}
+
+	var buf bytes.Buffer
+	if _, err := vk.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	verify, err := ps.NewVerifier(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(input[:], compressed); err != nil {
+		t.Fatal(err)
+	}
+	if err := verify([]*big.Int{input[0], input[1], big.NewInt(7)}, compressed); err == nil {
+		t.Error("expected an error for a wrong input")
+	}
+}
@@ -1,6 +1,7 @@
 package groth16
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -33,14 +34,35 @@ func (p Groth16Proof) EncodeEthABI() []byte {
 	return bz
 }
 
+func (p Groth16Proof) ProverType() string {
+	return Groth16ProverType
+}
+
+// ParseGroth16Proof parses the proof encoded by gnark's `Proof.MarshalSolidity` and validates it
 func ParseGroth16Proof(proofBytes []byte) (*Groth16Proof, error) {
+	if len(proofBytes) != 8*fpSize {
+		return nil, fmt.Errorf("invalid groth16 proof length: expected=%d actual=%d", 8*fpSize, len(proofBytes))
+	}
+	elements, err := parseFpElements(proofBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid groth16 proof: %w", err)
+	}
 	var proof Groth16Proof
-	for i := 0; i < 8; i++ {
-		proof[i] = new(big.Int).SetBytes(proofBytes[fpSize*i : fpSize*(i+1)])
+	copy(proof[:], elements)
+	if err := proof.Validate(); err != nil {
+		return nil, err
 	}
 	return &proof, nil
 }
 
+// Validate checks that every coordinate is a canonical base field element and the points are on the curve and in the correct subgroup
+func (p Groth16Proof) Validate() error {
+	if _, err := p.toGnarkProof(); err != nil {
+		return fmt.Errorf("invalid groth16 proof: %w", err)
+	}
+	return nil
+}
+
 func EthABIEncodeGroth16Proof(proof Groth16Proof) ([]byte, error) {
 	packer := abi.Arguments{
 		{Type: groth16ProofABI},
@@ -1,6 +1,7 @@
 package groth16
 
 import (
+	"encoding/binary"
 	fmt "fmt"
 	"math/big"
 
@@ -32,28 +33,58 @@ func (p Groth16CommitmentProof) EncodeEthABI() []byte {
 	return bz
 }
 
+func (p Groth16CommitmentProof) ProverType() string {
+	return Groth16CommitmentProverType
+}
+
+// groth16CommitmentProofSize is the length of `Proof.MarshalSolidity` with `nbCommitments` commitments: Ar|Bs|Krs, the number of commitments, the commitments and the proof of knowledge
+const groth16CommitmentProofSize = 8*fpSize + 4 + 2*nbCommitments*fpSize + 2*fpSize
+
+// ParseGroth16CommitmentProof parses the proof encoded by gnark's `Proof.MarshalSolidity` and validates it
 func ParseGroth16CommitmentProof(proofBytes []byte) (*Groth16CommitmentProof, error) {
-	var proof Groth16CommitmentProof
-	// proof.Ar, proof.Bs, proof.Krs
-	for i := 0; i < 8; i++ {
-		proof.Proof[i] = new(big.Int).SetBytes(proofBytes[fpSize*i : fpSize*(i+1)])
+	if len(proofBytes) < 8*fpSize+4 {
+		return nil, fmt.Errorf("invalid groth16 commitment proof length: %d", len(proofBytes))
 	}
-	c := new(big.Int).SetBytes(proofBytes[fpSize*8 : fpSize*8+4])
-	commitmentCount := int(c.Int64())
-	if commitmentCount != nbCommitments {
+	if commitmentCount := binary.BigEndian.Uint32(proofBytes[8*fpSize : 8*fpSize+4]); commitmentCount != nbCommitments {
 		return nil, fmt.Errorf("commitmentCount != nbCommitments: %d != %d", commitmentCount, nbCommitments)
 	}
-	// commitments
-	for i := 0; i < 2*commitmentCount; i++ {
-		proof.Commitments[i] = new(big.Int).SetBytes(proofBytes[fpSize*8+4+i*fpSize : fpSize*8+4+(i+1)*fpSize])
+	if len(proofBytes) != groth16CommitmentProofSize {
+		return nil, fmt.Errorf("invalid groth16 commitment proof length: expected=%d actual=%d", groth16CommitmentProofSize, len(proofBytes))
+	}
+	// proof.Ar, proof.Bs, proof.Krs
+	elements, err := parseFpElements(proofBytes[:8*fpSize])
+	if err != nil {
+		return nil, fmt.Errorf("invalid groth16 commitment proof: %w", err)
+	}
+	// commitments and commitmentPok
+	commitmentElements, err := parseFpElements(proofBytes[8*fpSize+4:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid groth16 commitment proof: %w", err)
+	}
+	var proof Groth16CommitmentProof
+	copy(proof.Proof[:], elements)
+	copy(proof.Commitments[:], commitmentElements[:2*nbCommitments])
+	copy(proof.CommitmentPok[:], commitmentElements[2*nbCommitments:])
+	if err := proof.Validate(); err != nil {
+		return nil, err
 	}
-	// commitmentPok
-	proof.CommitmentPok[0] = new(big.Int).SetBytes(proofBytes[fpSize*8+4+2*commitmentCount*fpSize : fpSize*8+4+2*commitmentCount*fpSize+fpSize])
-	proof.CommitmentPok[1] = new(big.Int).SetBytes(proofBytes[fpSize*8+4+2*commitmentCount*fpSize+fpSize : fpSize*8+4+2*commitmentCount*fpSize+2*fpSize])
-
 	return &proof, nil
 }
 
+// Validate checks that every coordinate is a canonical base field element and the points are on the curve and in the correct subgroup
+func (p Groth16CommitmentProof) Validate() error {
+	if err := Groth16Proof(p.Proof).Validate(); err != nil {
+		return err
+	}
+	if _, err := toG1(p.Commitments[0], p.Commitments[1]); err != nil {
+		return fmt.Errorf("invalid groth16 commitment: %w", err)
+	}
+	if _, err := toG1(p.CommitmentPok[0], p.CommitmentPok[1]); err != nil {
+		return fmt.Errorf("invalid groth16 commitment proof of knowledge: %w", err)
+	}
+	return nil
+}
+
 func EthABIEncodeGroth16CommitmentProof(proof Groth16CommitmentProof) ([]byte, error) {
 	packer := abi.Arguments{
 		{Type: groth16CommitmentProofABI},
@@ -1,11 +1,14 @@
 package groth16
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
 	"reflect"
 	"testing"
 	"testing/quick"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
 )
 
 func TestGroth16ProofEncoding(t *testing.T) {
@@ -56,3 +59,121 @@ func TestGroth16CommitmentProofEncoding(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// newTestCommitmentProofBytes appends a commitment and its proof of knowledge to the proof in the layout of `Proof.MarshalSolidity`.
+// The points are valid but the commitment is not verifiable.
+func newTestCommitmentProofBytes(proof []byte) []byte {
+	bz := append([]byte{}, proof...)
+	bz = append(bz, 0, 0, 0, nbCommitments)
+	bz = append(bz, proof[:2*fpSize]...)
+	return append(bz, proof[6*fpSize:]...)
+}
+
+func TestParseGroth16Proof(t *testing.T) {
+	_, _, proof := newTestProof(t)
+	modulus := make([]byte, fpSize)
+	fp.Modulus().FillBytes(modulus)
+
+	cases := []struct {
+		name   string
+		modify func(bz []byte) []byte
+		ok     bool
+	}{
+		{"valid", func(bz []byte) []byte { return bz }, true},
+		{"empty", func(bz []byte) []byte { return nil }, false},
+		{"truncated", func(bz []byte) []byte { return bz[:len(bz)-1] }, false},
+		{"trailing bytes", func(bz []byte) []byte { return append(bz, 0) }, false},
+		{"non-canonical element", func(bz []byte) []byte { return append(modulus, bz[fpSize:]...) }, false},
+		{"A not on curve", func(bz []byte) []byte {
+			bz[2*fpSize-1] ^= 1
+			return bz
+		}, false},
+		{"B not on curve", func(bz []byte) []byte {
+			bz[6*fpSize-1] ^= 1
+			return bz
+		}, false},
+		{"swapped B coordinates", func(bz []byte) []byte {
+			x1 := append([]byte{}, bz[2*fpSize:3*fpSize]...)
+			copy(bz[2*fpSize:3*fpSize], bz[3*fpSize:4*fpSize])
+			copy(bz[3*fpSize:4*fpSize], x1)
+			return bz
+		}, false},
+	}
+	for _, c := range cases {
+		p, err := ParseGroth16Proof(c.modify(bytes.Clone(proof)))
+		if c.ok != (err == nil) {
+			t.Errorf("%s: unexpected result: %v", c.name, err)
+		} else if c.ok && !bytes.Equal(p.EncodeEthABI(), proof) {
+			t.Errorf("%s: unexpected proof: %v", c.name, p)
+		}
+	}
+}
+
+func TestParseGroth16CommitmentProof(t *testing.T) {
+	_, _, proof := newTestProof(t)
+	valid := newTestCommitmentProofBytes(proof)
+
+	cases := []struct {
+		name   string
+		modify func(bz []byte) []byte
+		ok     bool
+	}{
+		{"valid", func(bz []byte) []byte { return bz }, true},
+		{"without commitments", func(bz []byte) []byte { return bz[:8*fpSize] }, false},
+		{"truncated", func(bz []byte) []byte { return bz[:len(bz)-1] }, false},
+		{"trailing bytes", func(bz []byte) []byte { return append(bz, 0) }, false},
+		{"two commitments", func(bz []byte) []byte {
+			bz[8*fpSize+3] = 2
+			return bz
+		}, false},
+		{"commitment not on curve", func(bz []byte) []byte {
+			bz[8*fpSize+4+2*fpSize-1] ^= 1
+			return bz
+		}, false},
+		{"pok not on curve", func(bz []byte) []byte {
+			bz[len(bz)-1] ^= 1
+			return bz
+		}, false},
+	}
+	for _, c := range cases {
+		if _, err := ParseGroth16CommitmentProof(c.modify(bytes.Clone(valid))); c.ok != (err == nil) {
+			t.Errorf("%s: unexpected result: %v", c.name, err)
+		}
+	}
+}
+
+func FuzzParseGroth16Proof(f *testing.F) {
+	_, _, proof := newTestProof(f)
+	f.Add(proof)
+	f.Add(proof[:len(proof)-1])
+	f.Add(make([]byte, 8*fpSize))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		p, err := ParseGroth16Proof(data)
+		if err != nil {
+			return
+		}
+		if err := p.Validate(); err != nil {
+			t.Fatalf("the parsed proof must be valid: %v", err)
+		}
+		if !bytes.Equal(p.EncodeEthABI(), data) {
+			t.Fatalf("the parsed proof must be encoded into the same bytes: %x", data)
+		}
+	})
+}
+
+func FuzzParseGroth16CommitmentProof(f *testing.F) {
+	_, _, proof := newTestProof(f)
+	valid := newTestCommitmentProofBytes(proof)
+	f.Add(valid)
+	f.Add(valid[:len(valid)-1])
+	f.Add(proof)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		p, err := ParseGroth16CommitmentProof(data)
+		if err != nil {
+			return
+		}
+		if err := p.Validate(); err != nil {
+			t.Fatalf("the parsed proof must be valid: %v", err)
+		}
+	})
+}
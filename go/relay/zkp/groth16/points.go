@@ -0,0 +1,77 @@
+package groth16
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+)
+
+// parseFpElements splits `bz` into big-endian 32-byte words and rejects the words that are not canonical base field elements
+func parseFpElements(bz []byte) ([]*big.Int, error) {
+	if len(bz)%fpSize != 0 {
+		return nil, fmt.Errorf("invalid length of field elements: %d", len(bz))
+	}
+	elements := make([]*big.Int, len(bz)/fpSize)
+	for i := range elements {
+		elements[i] = new(big.Int).SetBytes(bz[fpSize*i : fpSize*(i+1)])
+		if elements[i].Cmp(fp.Modulus()) >= 0 {
+			return nil, fmt.Errorf("element %d is not a canonical base field element: 0x%x", i, elements[i])
+		}
+	}
+	return elements, nil
+}
+
+// toFpElement converts `v` into the base field element without reducing it
+func toFpElement(v *big.Int) (fp.Element, error) {
+	var e fp.Element
+	if v == nil || v.Sign() < 0 || v.Cmp(fp.Modulus()) >= 0 {
+		return e, fmt.Errorf("not a canonical base field element: %v", v)
+	}
+	e.SetBigInt(v)
+	return e, nil
+}
+
+// toG1 returns the G1 point (x, y) after checking that it is on the curve and in the subgroup
+func toG1(x, y *big.Int) (bn254.G1Affine, error) {
+	var p bn254.G1Affine
+	var err error
+	if p.X, err = toFpElement(x); err != nil {
+		return p, fmt.Errorf("invalid x: %w", err)
+	}
+	if p.Y, err = toFpElement(y); err != nil {
+		return p, fmt.Errorf("invalid y: %w", err)
+	}
+	if !p.IsOnCurve() {
+		return p, fmt.Errorf("G1 point is not on the curve: (0x%x, 0x%x)", x, y)
+	}
+	if !p.IsInSubGroup() {
+		return p, fmt.Errorf("G1 point is not in the subgroup: (0x%x, 0x%x)", x, y)
+	}
+	return p, nil
+}
+
+// toG2 returns the G2 point whose coordinates are ordered as (x.a1, x.a0, y.a1, y.a0) after checking that it is on the curve and in the subgroup
+func toG2(x1, x0, y1, y0 *big.Int) (bn254.G2Affine, error) {
+	var p bn254.G2Affine
+	var err error
+	for _, c := range []struct {
+		name string
+		e    *fp.Element
+		v    *big.Int
+	}{
+		{"x.a1", &p.X.A1, x1}, {"x.a0", &p.X.A0, x0}, {"y.a1", &p.Y.A1, y1}, {"y.a0", &p.Y.A0, y0},
+	} {
+		if *c.e, err = toFpElement(c.v); err != nil {
+			return p, fmt.Errorf("invalid %s: %w", c.name, err)
+		}
+	}
+	if !p.IsOnCurve() {
+		return p, fmt.Errorf("G2 point is not on the curve")
+	}
+	if !p.IsInSubGroup() {
+		return p, fmt.Errorf("G2 point is not in the subgroup")
+	}
+	return p, nil
+}
@@ -0,0 +1,100 @@
+package groth16
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+
+	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp"
+)
+
+// publicInputs is the layout of the public inputs of the Groth16 wrapper circuit
+var publicInputs = []string{"verifierDigest", "inputHash", "outputHash"}
+
+func init() {
+	zkp.Register(zkp.ProvingSystem{
+		Name:         Groth16ProverType,
+		PublicInputs: publicInputs,
+		ParseProof: func(bz []byte) (zkp.ZKProof, error) {
+			return ParseGroth16Proof(bz)
+		},
+		DecodeEthABI: func(bz []byte) (zkp.ZKProof, error) {
+			return EthABIDecodeGroth16Proof(bz)
+		},
+		NewVerifier: newGroth16Verifier,
+	})
+	zkp.Register(zkp.ProvingSystem{
+		Name:         Groth16CompressedProverType,
+		PublicInputs: publicInputs,
+		// the zk prover returns the uncompressed proof
+		ParseProof: func(bz []byte) (zkp.ZKProof, error) {
+			p, err := ParseGroth16Proof(bz)
+			if err != nil {
+				return nil, err
+			}
+			return p.Compress()
+		},
+		DecodeEthABI: func(bz []byte) (zkp.ZKProof, error) {
+			return EthABIDecodeGroth16CompressedProof(bz)
+		},
+		NewVerifier: func(r io.Reader) (zkp.Verifier, error) {
+			verify, err := newGroth16Verifier(r)
+			if err != nil {
+				return nil, err
+			}
+			return func(input []*big.Int, proof []byte) error {
+				c, err := EthABIDecodeGroth16CompressedProof(proof)
+				if err != nil {
+					return fmt.Errorf("failed to decode proof: %w", err)
+				}
+				p, err := c.Decompress()
+				if err != nil {
+					return err
+				}
+				return verify(input, p.EncodeEthABI())
+			}, nil
+		},
+	})
+	zkp.Register(zkp.ProvingSystem{
+		Name:         Groth16CommitmentProverType,
+		PublicInputs: publicInputs,
+		ParseProof: func(bz []byte) (zkp.ZKProof, error) {
+			return ParseGroth16CommitmentProof(bz)
+		},
+		DecodeEthABI: func(bz []byte) (zkp.ZKProof, error) {
+			return EthABIDecodeGroth16CommitmentProof(bz)
+		},
+		NewVerifier: newGroth16CommitmentVerifier,
+	})
+}
+
+// newGroth16Verifier reads the gnark verifying key and returns the verifier of Groth16Proof
+func newGroth16Verifier(r io.Reader) (zkp.Verifier, error) {
+	var vk groth16bn254.VerifyingKey
+	if _, err := vk.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("failed to read verifying key: %w", err)
+	}
+	verify := NewVerifier(&vk)
+	return func(input []*big.Int, proof []byte) error {
+		if len(input) != nbPublicInputs {
+			return fmt.Errorf("invalid number of public inputs: expected=%d actual=%d", nbPublicInputs, len(input))
+		}
+		return verify([nbPublicInputs]*big.Int(input), proof)
+	}, nil
+}
+
+// newGroth16CommitmentVerifier reads the gnark verifying key and returns the verifier of Groth16CommitmentProof
+func newGroth16CommitmentVerifier(r io.Reader) (zkp.Verifier, error) {
+	var vk groth16bn254.VerifyingKey
+	if _, err := vk.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("failed to read verifying key: %w", err)
+	}
+	verify := NewCommitmentVerifier(&vk)
+	return func(input []*big.Int, proof []byte) error {
+		if len(input) != nbPublicInputs {
+			return fmt.Errorf("invalid number of public inputs: expected=%d actual=%d", nbPublicInputs, len(input))
+		}
+		return verify([nbPublicInputs]*big.Int(input), proof)
+	}, nil
+}
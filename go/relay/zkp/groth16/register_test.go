@@ -0,0 +1,73 @@
+package groth16
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp"
+)
+
+func TestRegisteredProvingSystems(t *testing.T) {
+	vk, input, proof := newTestProof(t)
+
+	ps, err := zkp.Get(Groth16ProverType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ps.PublicInputs) != nbPublicInputs {
+		t.Fatalf("unexpected public inputs: %v", ps.PublicInputs)
+	}
+	p, err := ps.DecodeEthABI(proof)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.ProverType() != Groth16ProverType || !bytes.Equal(p.EncodeEthABI(), proof) {
+		t.Fatalf("unexpected proof: %v", p)
+	}
+
+	var buf bytes.Buffer
+	if _, err := vk.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	verify, err := ps.NewVerifier(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(input[:], proof); err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(input[:2], proof); err == nil {
+		t.Error("expected an error for the wrong number of inputs")
+	}
+	if err := verify([]*big.Int{input[0], input[1], big.NewInt(7)}, proof); err == nil {
+		t.Error("expected an error for a wrong input")
+	}
+
+	ps, err = zkp.Get(Groth16CommitmentProverType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cvk, _, cproof := newTestCommitmentProof(t)
+	buf.Reset()
+	if _, err := cvk.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	verify, err = ps.NewVerifier(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(input[:], cproof); err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(input[:], proof); err == nil {
+		t.Error("expected an error for a proof without the commitment")
+	}
+	if !reflect.DeepEqual(zkp.Names(), []string{Groth16ProverType, Groth16CommitmentProverType, Groth16CompressedProverType}) {
+		t.Errorf("unexpected names: %v", zkp.Names())
+	}
+	if _, err := zkp.Get("plonk"); err == nil {
+		t.Error("expected an error for an unregistered proving system")
+	}
+}
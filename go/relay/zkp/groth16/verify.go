@@ -0,0 +1,108 @@
+package groth16
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark/backend"
+	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+)
+
+// NewVerifier returns a function that verifies the ABI-encoded Groth16 proof with the public inputs in the same way as `Verifier.verifyProof`
+func NewVerifier(vk *groth16bn254.VerifyingKey) func(input [nbPublicInputs]*big.Int, proof []byte) error {
+	return func(input [nbPublicInputs]*big.Int, proof []byte) error {
+		p, err := EthABIDecodeGroth16Proof(proof)
+		if err != nil {
+			return fmt.Errorf("failed to decode proof: %w", err)
+		}
+		return Verify(vk, p, input[:])
+	}
+}
+
+// NewCommitmentVerifier returns a function that verifies the ABI-encoded Groth16 commitment proof with the public inputs in the same way as `Verifier.verifyProof` of Groth16CommitmentVerifier.sol
+func NewCommitmentVerifier(vk *groth16bn254.VerifyingKey) func(input [nbPublicInputs]*big.Int, proof []byte) error {
+	return func(input [nbPublicInputs]*big.Int, proof []byte) error {
+		p, err := EthABIDecodeGroth16CommitmentProof(proof)
+		if err != nil {
+			return fmt.Errorf("failed to decode proof: %w", err)
+		}
+		return VerifyCommitment(vk, p, input[:])
+	}
+}
+
+// Verify verifies the Groth16 proof with the public inputs
+func Verify(vk *groth16bn254.VerifyingKey, proof Groth16Proof, input []*big.Int) error {
+	gp, err := proof.toGnarkProof()
+	if err != nil {
+		return err
+	}
+	witness, err := toWitness(input)
+	if err != nil {
+		return err
+	}
+	return groth16bn254.Verify(gp, vk, witness)
+}
+
+// VerifyCommitment verifies the Groth16 commitment proof with the public inputs.
+// The commitment is hashed into the scalar field with sha256 as the verifier contract does.
+func VerifyCommitment(vk *groth16bn254.VerifyingKey, proof Groth16CommitmentProof, input []*big.Int) error {
+	gp, err := proof.toGnarkProof()
+	if err != nil {
+		return err
+	}
+	witness, err := toWitness(input)
+	if err != nil {
+		return err
+	}
+	if len(vk.PublicAndCommitmentCommitted) != nbCommitments {
+		return fmt.Errorf("invalid number of commitments in the verifying key: expected=%d actual=%d", nbCommitments, len(vk.PublicAndCommitmentCommitted))
+	}
+	return groth16bn254.Verify(gp, vk, witness, backend.WithVerifierHashToFieldFunction(sha256.New()))
+}
+
+func toWitness(input []*big.Int) (fr.Vector, error) {
+	witness := make(fr.Vector, len(input))
+	for i, in := range input {
+		if in == nil || in.Cmp(fr.Modulus()) >= 0 {
+			return nil, fmt.Errorf("public input is not in the scalar field: index=%d value=%v", i, in)
+		}
+		witness[i].SetBigInt(in)
+	}
+	return witness, nil
+}
+
+// toGnarkProof converts the proof whose G2 coordinates are ordered as (a1, a0) into the gnark proof after validating the points
+func (p Groth16Proof) toGnarkProof() (*groth16bn254.Proof, error) {
+	var gp groth16bn254.Proof
+	var err error
+	if gp.Ar, err = toG1(p[0], p[1]); err != nil {
+		return nil, fmt.Errorf("invalid A: %w", err)
+	}
+	if gp.Bs, err = toG2(p[2], p[3], p[4], p[5]); err != nil {
+		return nil, fmt.Errorf("invalid B: %w", err)
+	}
+	if gp.Krs, err = toG1(p[6], p[7]); err != nil {
+		return nil, fmt.Errorf("invalid C: %w", err)
+	}
+	return &gp, nil
+}
+
+// toGnarkProof converts the commitment proof into the gnark proof after validating the points
+func (p Groth16CommitmentProof) toGnarkProof() (*groth16bn254.Proof, error) {
+	gp, err := Groth16Proof(p.Proof).toGnarkProof()
+	if err != nil {
+		return nil, err
+	}
+	commitment, err := toG1(p.Commitments[0], p.Commitments[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid commitment: %w", err)
+	}
+	gp.Commitments = []curve.G1Affine{commitment}
+	if gp.CommitmentPok, err = toG1(p.CommitmentPok[0], p.CommitmentPok[1]); err != nil {
+		return nil, fmt.Errorf("invalid commitment proof of knowledge: %w", err)
+	}
+	return gp, nil
+}
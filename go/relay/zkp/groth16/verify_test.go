@@ -0,0 +1,159 @@
+package groth16
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark/backend"
+	"github.com/consensys/gnark/backend/groth16"
+	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+)
+
+// testCircuit has the same number of public inputs as the step and skip circuits
+type testCircuit struct {
+	A, B, C frontend.Variable `gnark:",public"`
+	S       frontend.Variable
+}
+
+func (c *testCircuit) Define(api frontend.API) error {
+	api.AssertIsEqual(api.Mul(c.S, c.S), api.Add(c.A, c.B, c.C))
+	return nil
+}
+
+// newTestProof returns the verifying key and the ABI-encoded proof of `testCircuit` for the public inputs 1, 2 and 6
+func newTestProof(t testing.TB) (*groth16bn254.VerifyingKey, [nbPublicInputs]*big.Int, []byte) {
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &testCircuit{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk, vk, err := groth16.Setup(ccs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := frontend.NewWitness(&testCircuit{A: 1, B: 2, C: 6, S: 3}, ecc.BN254.ScalarField())
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof, err := groth16.Prove(ccs, pk, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := ParseGroth16Proof(proof.(*groth16bn254.Proof).MarshalSolidity())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return vk.(*groth16bn254.VerifyingKey), [nbPublicInputs]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(6)}, p.EncodeEthABI()
+}
+
+// testCommitmentCircuit commits to the secret variable like the wrapper circuit built with the commitment
+type testCommitmentCircuit struct {
+	A, B, C frontend.Variable `gnark:",public"`
+	S       frontend.Variable
+}
+
+func (c *testCommitmentCircuit) Define(api frontend.API) error {
+	committer, ok := api.Compiler().(frontend.Committer)
+	if !ok {
+		return fmt.Errorf("compiler does not commit")
+	}
+	cm, err := committer.Commit(c.S)
+	if err != nil {
+		return err
+	}
+	api.AssertIsDifferent(cm, 0)
+	api.AssertIsEqual(api.Mul(c.S, c.S), api.Add(c.A, c.B, c.C))
+	return nil
+}
+
+// newTestCommitmentProof returns the verifying key and the ABI-encoded commitment proof of `testCommitmentCircuit` for the public inputs 1, 2 and 6
+func newTestCommitmentProof(t testing.TB) (*groth16bn254.VerifyingKey, [nbPublicInputs]*big.Int, []byte) {
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &testCommitmentCircuit{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk, vk, err := groth16.Setup(ccs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := frontend.NewWitness(&testCommitmentCircuit{A: 1, B: 2, C: 6, S: 3}, ecc.BN254.ScalarField())
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof, err := groth16.Prove(ccs, pk, w, backend.WithProverHashToFieldFunction(sha256.New()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := ParseGroth16CommitmentProof(proof.(*groth16bn254.Proof).MarshalSolidity())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return vk.(*groth16bn254.VerifyingKey), [nbPublicInputs]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(6)}, p.EncodeEthABI()
+}
+
+func TestVerify(t *testing.T) {
+	vk, input, proof := newTestProof(t)
+	verify := NewVerifier(vk)
+	if err := verify(input, proof); err != nil {
+		t.Fatal(err)
+	}
+
+	wrongInput := input
+	wrongInput[2] = big.NewInt(7)
+	if err := verify(wrongInput, proof); err == nil {
+		t.Error("expected an error for a wrong input")
+	}
+
+	p, err := EthABIDecodeGroth16Proof(proof)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p[0], p[6] = p[6], p[0]
+	p[1], p[7] = p[7], p[1]
+	if err := verify(input, p.EncodeEthABI()); err == nil {
+		t.Error("expected an error for a wrong proof")
+	}
+
+	p, _ = EthABIDecodeGroth16Proof(proof)
+	p[0] = new(big.Int).Add(p[0], fp.Modulus())
+	if err := verify(input, p.EncodeEthABI()); err == nil {
+		t.Error("expected an error for a non-canonical proof element")
+	}
+	if err := verify(input, proof[:len(proof)-1]); err == nil {
+		t.Error("expected an error for a truncated proof")
+	}
+}
+
+func TestVerifyCommitment(t *testing.T) {
+	vk, input, proof := newTestCommitmentProof(t)
+	verify := NewCommitmentVerifier(vk)
+	if err := verify(input, proof); err != nil {
+		t.Fatal(err)
+	}
+
+	wrongInput := input
+	wrongInput[2] = big.NewInt(7)
+	if err := verify(wrongInput, proof); err == nil {
+		t.Error("expected an error for a wrong input")
+	}
+
+	p, err := EthABIDecodeGroth16CommitmentProof(proof)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Commitments, p.CommitmentPok = p.CommitmentPok, p.Commitments
+	if err := verify(input, p.EncodeEthABI()); err == nil {
+		t.Error("expected an error for a wrong commitment")
+	}
+
+	// the verifying key of a circuit without the commitment
+	plainVK, _, _ := newTestProof(t)
+	if err := NewCommitmentVerifier(plainVK)(input, proof); err == nil {
+		t.Error("expected an error for a verifying key without the commitment")
+	}
+}
@@ -0,0 +1,38 @@
+//go:build ignore
+
+// This program regenerates the keys of the mock Groth16 circuit and its Solidity verifier.
+// The contract, the embedded keys and the test vector must be updated together.
+package main
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp/mock"
+)
+
+const solidityPath = "../../../../contracts/mock/MockGroth16Verifier.sol"
+
+func main() {
+	var pk, vk, sol bytes.Buffer
+	if err := mock.SetupGroth16(&pk, &vk, &sol); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.MkdirAll("data", 0755); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join("data", "mock_groth16.pk"), pk.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join("data", "mock_groth16.vk"), vk.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+	// rename the contract to avoid the conflict with the verifier of the real circuits
+	contract := strings.Replace(sol.String(), "contract Verifier {", "contract MockGroth16Verifier {", 1)
+	if err := os.WriteFile(solidityPath, []byte(contract), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
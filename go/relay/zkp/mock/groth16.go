@@ -0,0 +1,175 @@
+package mock
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"io"
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	gnarkgroth16 "github.com/consensys/gnark/backend/groth16"
+	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp"
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp/groth16"
+)
+
+//go:generate go run gen_groth16.go
+
+const (
+	MockGroth16ProverType = "mock-groth16"
+)
+
+var (
+	//go:embed data/mock_groth16.pk
+	mockGroth16ProvingKey []byte
+	//go:embed data/mock_groth16.vk
+	mockGroth16VerifyingKey []byte
+)
+
+// mockGroth16Circuit is a stand-in for the step and skip circuits. It has the same public inputs and binds each of them with a single constraint.
+type mockGroth16Circuit struct {
+	VerifierDigest frontend.Variable `gnark:",public"`
+	InputHash      frontend.Variable `gnark:",public"`
+	OutputHash     frontend.Variable `gnark:",public"`
+	Product        frontend.Variable
+}
+
+func (c *mockGroth16Circuit) Define(api frontend.API) error {
+	api.AssertIsEqual(api.Mul(api.Add(c.VerifierDigest, c.InputHash), c.OutputHash), c.Product)
+	return nil
+}
+
+type mockGroth16Keys struct {
+	ccs constraint.ConstraintSystem
+	pk  groth16bn254.ProvingKey
+	vk  groth16bn254.VerifyingKey
+}
+
+var (
+	loadMockGroth16Keys = sync.OnceValues(func() (*mockGroth16Keys, error) {
+		var keys mockGroth16Keys
+		var err error
+		if keys.ccs, err = compileMockGroth16Circuit(); err != nil {
+			return nil, err
+		}
+		if _, err := keys.pk.ReadFrom(bytes.NewReader(mockGroth16ProvingKey)); err != nil {
+			return nil, fmt.Errorf("failed to read the proving key: %w", err)
+		}
+		if _, err := keys.vk.ReadFrom(bytes.NewReader(mockGroth16VerifyingKey)); err != nil {
+			return nil, fmt.Errorf("failed to read the verifying key: %w", err)
+		}
+		return &keys, nil
+	})
+)
+
+func compileMockGroth16Circuit() (constraint.ConstraintSystem, error) {
+	return frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &mockGroth16Circuit{})
+}
+
+// SetupGroth16 runs the setup of the mock circuit and writes the proving key, the verifying key and the Solidity verifier
+func SetupGroth16(pkw, vkw, solw io.Writer) error {
+	ccs, err := compileMockGroth16Circuit()
+	if err != nil {
+		return err
+	}
+	pk, vk, err := gnarkgroth16.Setup(ccs)
+	if err != nil {
+		return err
+	}
+	if _, err := pk.WriteTo(pkw); err != nil {
+		return err
+	}
+	if _, err := vk.WriteTo(vkw); err != nil {
+		return err
+	}
+	return vk.ExportSolidity(solw)
+}
+
+// mockGroth16Proof is a Groth16 proof of the mock circuit, which is verified by `TendermintZKLightClientMockGroth16`
+type mockGroth16Proof struct {
+	groth16.Groth16Proof
+}
+
+var _ zkp.ZKProof = (*mockGroth16Proof)(nil)
+
+func (p mockGroth16Proof) ProverType() string {
+	return MockGroth16ProverType
+}
+
+// ProveGroth16 generates a Groth16 proof of the mock circuit for the public inputs
+func ProveGroth16(input [3]*big.Int) (zkp.ZKProof, error) {
+	keys, err := loadMockGroth16Keys()
+	if err != nil {
+		return nil, err
+	}
+	sum := new(big.Int).Add(input[0], input[1])
+	product := new(big.Int).Mod(new(big.Int).Mul(sum, input[2]), ecc.BN254.ScalarField())
+	w, err := frontend.NewWitness(&mockGroth16Circuit{
+		VerifierDigest: input[0],
+		InputHash:      input[1],
+		OutputHash:     input[2],
+		Product:        product,
+	}, ecc.BN254.ScalarField())
+	if err != nil {
+		return nil, err
+	}
+	proof, err := gnarkgroth16.Prove(keys.ccs, &keys.pk, w)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prove: %w", err)
+	}
+	return parseMockGroth16Proof(proof.(*groth16bn254.Proof).MarshalSolidity())
+}
+
+func parseMockGroth16Proof(bz []byte) (zkp.ZKProof, error) {
+	p, err := groth16.ParseGroth16Proof(bz)
+	if err != nil {
+		return nil, err
+	}
+	return mockGroth16Proof{*p}, nil
+}
+
+func decodeMockGroth16Proof(bz []byte) (zkp.ZKProof, error) {
+	p, err := groth16.EthABIDecodeGroth16Proof(bz)
+	if err != nil {
+		return nil, err
+	}
+	return mockGroth16Proof{p}, nil
+}
+
+// VerifyGroth16 verifies the ABI-encoded proof with the embedded verifying key of the mock circuit
+func VerifyGroth16(input []*big.Int, proof []byte) error {
+	keys, err := loadMockGroth16Keys()
+	if err != nil {
+		return err
+	}
+	p, err := groth16.EthABIDecodeGroth16Proof(proof)
+	if err != nil {
+		return fmt.Errorf("failed to decode proof: %w", err)
+	}
+	return groth16.Verify(&keys.vk, p, input)
+}
+
+func init() {
+	zkp.Register(zkp.ProvingSystem{
+		Name:         MockGroth16ProverType,
+		PublicInputs: []string{"verifierDigest", "inputHash", "outputHash"},
+		ParseProof:   parseMockGroth16Proof,
+		DecodeEthABI: decodeMockGroth16Proof,
+		// the verifying key of the mock circuit is embedded, so it has no native verifier to be configured with `verifying_key_path`
+		Prove: func(req zkp.ProveRequest) (zkp.ZKProof, []*big.Int, error) {
+			if len(req.Input) != 3 {
+				return nil, nil, fmt.Errorf("invalid number of public inputs: %v", len(req.Input))
+			}
+			p, err := ProveGroth16([3]*big.Int(req.Input))
+			if err != nil {
+				return nil, nil, err
+			}
+			return p, req.Input, nil
+		},
+	})
+}
@@ -0,0 +1,104 @@
+package mock
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp"
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp/groth16"
+)
+
+func TestProveGroth16(t *testing.T) {
+	ps, err := zkp.Get(MockGroth16ProverType)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := [3]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)}
+	p, err := ProveGroth16(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.ProverType() != MockGroth16ProverType {
+		t.Fatalf("unexpected prover type: %v", p.ProverType())
+	}
+	if _, err := ps.DecodeEthABI(p.EncodeEthABI()); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyGroth16(input[:], p.EncodeEthABI()); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyGroth16([]*big.Int{input[0], input[1], big.NewInt(4)}, p.EncodeEthABI()); err == nil {
+		t.Error("expected an error for a wrong input")
+	}
+}
+
+// TestGroth16ProofData checks that the test vector of `MockGroth16Verifier.t.sol` is valid for the embedded verifying key
+func TestGroth16ProofData(t *testing.T) {
+	bz, err := os.ReadFile("../../../../test/data/mock_groth16_proof.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var data struct {
+		Input [3]string `json:"input"`
+		Proof [8]string `json:"proof"`
+	}
+	if err := json.Unmarshal(bz, &data); err != nil {
+		t.Fatal(err)
+	}
+	var input []*big.Int
+	for _, s := range data.Input {
+		v, ok := new(big.Int).SetString(s, 0)
+		if !ok {
+			t.Fatalf("invalid input: %v", s)
+		}
+		input = append(input, v)
+	}
+	var proof groth16.Groth16Proof
+	for i, s := range data.Proof {
+		var ok bool
+		if proof[i], ok = new(big.Int).SetString(s, 0); !ok {
+			t.Fatalf("invalid proof element: %v", s)
+		}
+	}
+
+	if err := VerifyGroth16(input, proof.EncodeEthABI()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNoNativeVerifier(t *testing.T) {
+	// the mocks cannot be configured with a verifying key
+	for _, name := range []string{MockProverType, MockGroth16ProverType} {
+		ps, err := zkp.Get(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ps.NewVerifier != nil {
+			t.Errorf("%s: unexpected native verifier", name)
+		}
+	}
+}
+
+func TestProveHook(t *testing.T) {
+	input := []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)}
+	for _, name := range []string{MockProverType, MockGroth16ProverType} {
+		ps, err := zkp.Get(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, proofInput, err := ps.Prove(zkp.ProveRequest{TrustedHeight: 1, TargetHeight: 2, Input: input})
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if p.ProverType() != name || len(proofInput) != len(input) {
+			t.Errorf("%s: unexpected proof: %v %v", name, p.ProverType(), proofInput)
+		}
+		verify := map[string]zkp.Verifier{MockProverType: Verify, MockGroth16ProverType: VerifyGroth16}[name]
+		if err := verify(proofInput, p.EncodeEthABI()); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+}
@@ -1,19 +1,57 @@
 package mock
 
-import "github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp"
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp"
+)
 
 const (
 	MockProverType = "mock"
 )
 
+var mockProofBytes = []byte("mock")
+
 type mockProof struct{}
 
 var _ zkp.ZKProof = (*mockProof)(nil)
 
 func (p mockProof) EncodeEthABI() []byte {
-	return []byte("mock")
+	return mockProofBytes
+}
+
+func (p mockProof) ProverType() string {
+	return MockProverType
 }
 
 func GetMockProof() zkp.ZKProof {
 	return mockProof{}
 }
+
+// Verify accepts any inputs with the mock proof as `TendermintZKLightClientMock` does
+func Verify(input []*big.Int, proof []byte) error {
+	_, err := decodeMockProof(proof)
+	return err
+}
+
+func decodeMockProof(bz []byte) (zkp.ZKProof, error) {
+	if !bytes.Equal(bz, mockProofBytes) {
+		return nil, fmt.Errorf("invalid mock proof: %x", bz)
+	}
+	return mockProof{}, nil
+}
+
+func init() {
+	zkp.Register(zkp.ProvingSystem{
+		Name:         MockProverType,
+		PublicInputs: []string{"verifierDigest", "inputHash", "outputHash"},
+		ParseProof:   decodeMockProof,
+		DecodeEthABI: decodeMockProof,
+		// the mock has no verifying key, so it has no native verifier to be configured with `verifying_key_path`
+		Prove: func(req zkp.ProveRequest) (zkp.ZKProof, []*big.Int, error) {
+			return mockProof{}, req.Input, nil
+		},
+	})
+}
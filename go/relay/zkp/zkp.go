@@ -1,5 +1,89 @@
 package zkp
 
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"sync"
+)
+
 type ZKProof interface {
 	EncodeEthABI() []byte
+	// ProverType returns the name of the proving system that generated the proof
+	ProverType() string
+}
+
+// Verifier verifies the ABI-encoded proof with the public inputs
+type Verifier func(input []*big.Int, proof []byte) error
+
+// ProvingSystem is a proving backend of the zk prover
+type ProvingSystem struct {
+	// Name is the prover type configured with `prover_type`
+	Name string
+	// PublicInputs are the names of the public inputs in the order the verifier expects
+	PublicInputs []string
+	// ParseProof parses the proof in the response of the zk prover
+	ParseProof func(bz []byte) (ZKProof, error)
+	// DecodeEthABI decodes the proof encoded by `ZKProof.EncodeEthABI`
+	DecodeEthABI func(bz []byte) (ZKProof, error)
+	// NewVerifier returns the native verifier with the serialized verifying key.
+	// It is nil if the proving system has no native verifier.
+	NewVerifier func(vk io.Reader) (Verifier, error)
+	// Prove generates the proof in the relayer process instead of requesting `/prove` of the zk prover, and returns it with its public inputs.
+	// It is nil if the zk prover generates the proofs.
+	Prove func(req ProveRequest) (ZKProof, []*big.Int, error)
+}
+
+// ProveRequest is the request to `ProvingSystem.Prove`
+type ProveRequest struct {
+	TrustedHeight uint64
+	TargetHeight  uint64
+	// Input is the public inputs computed from the headers at the heights
+	Input []*big.Int
+	// ProverAddress is the address of the zk prover configured with `zk_prover_addr`
+	ProverAddress string
+	// DataDir is the gnark data directory configured with `gnark_data_dir`
+	DataDir string
+}
+
+var (
+	mu             sync.RWMutex
+	provingSystems = make(map[string]ProvingSystem)
+)
+
+// Register makes the proving system available by its name. It panics if the name is already registered.
+func Register(ps ProvingSystem) {
+	mu.Lock()
+	defer mu.Unlock()
+	if ps.Name == "" || ps.ParseProof == nil || ps.DecodeEthABI == nil {
+		panic(fmt.Errorf("invalid proving system: %v", ps.Name))
+	}
+	if _, ok := provingSystems[ps.Name]; ok {
+		panic(fmt.Errorf("proving system already registered: %v", ps.Name))
+	}
+	provingSystems[ps.Name] = ps
+}
+
+// Get returns the proving system registered with `name`
+func Get(name string) (ProvingSystem, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+	ps, ok := provingSystems[name]
+	if !ok {
+		return ProvingSystem{}, fmt.Errorf("unsupported proof type: %s", name)
+	}
+	return ps, nil
+}
+
+// Names returns the sorted names of the registered proving systems
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	var names []string
+	for name := range provingSystems {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
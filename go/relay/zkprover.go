@@ -1,21 +1,19 @@
 package relay
 
 import (
-	"bytes"
 	"context"
-	"crypto/sha256"
-	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math/big"
 	"net/http"
+	"os"
 	"strings"
+	"sync"
 
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/datachainlab/tendermint-zk-ibc/go/inputs"
 	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp"
-	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp/groth16"
-	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp/mock"
 )
 
 type ZKProverClient struct {
@@ -24,63 +22,162 @@ type ZKProverClient struct {
 	TMClient           rpcclient.Client
 	StepVerifierDigest []byte
 	SkipVerifierDigest []byte
+
+	// GnarkDataDir is passed to the proving system that proves in the relayer process
+	GnarkDataDir string
+	// verifier is set if the verifying key is configured
+	verifier func() (zkp.Verifier, error)
 }
 
-func NewZKProverClient(proverType string, addr string, stepVerifierDigest, skipVerifierDigest []byte, tmClient rpcclient.Client) ZKProverClient {
-	return ZKProverClient{ProverAddress: addr, ProverType: proverType, StepVerifierDigest: stepVerifierDigest, SkipVerifierDigest: skipVerifierDigest, TMClient: tmClient}
+func NewZKProverClient(proverType string, addr string, gnarkDataDir string, verifyingKeyPath string, stepVerifierDigest, skipVerifierDigest []byte, tmClient rpcclient.Client) ZKProverClient {
+	zpc := ZKProverClient{ProverAddress: addr, ProverType: proverType, StepVerifierDigest: stepVerifierDigest, SkipVerifierDigest: skipVerifierDigest, TMClient: tmClient, GnarkDataDir: gnarkDataDir}
+	if verifyingKeyPath != "" {
+		zpc.verifier = newZKProofVerifier(proverType, verifyingKeyPath)
+	}
+	return zpc
 }
 
+// newZKProofVerifier returns a function that reads the verifying key on the first call
+func newZKProofVerifier(proverType string, verifyingKeyPath string) func() (zkp.Verifier, error) {
+	return sync.OnceValues(func() (zkp.Verifier, error) {
+		ps, err := zkp.Get(proverType)
+		if err != nil {
+			return nil, err
+		}
+		if ps.NewVerifier == nil {
+			return nil, fmt.Errorf("prover type %v has no native verifier", proverType)
+		}
+		f, err := os.Open(verifyingKeyPath)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return ps.NewVerifier(f)
+	})
+}
+
+// Prove returns the proof of the headers at the heights. If the verifying key is configured, the proof is verified before it is returned.
 func (zpc ZKProverClient) Prove(trustedHeight uint64, targetHeight uint64) (*ZKProofAndInput, error) {
-	if zpc.ProverType == mock.MockProverType {
-		return zpc.proveMock(trustedHeight, targetHeight)
+	pi, err := zpc.prove(trustedHeight, targetHeight)
+	if err != nil {
+		return nil, err
+	}
+	if err := zpc.verifyWithKey(trustedHeight, targetHeight, pi); err != nil {
+		return nil, err
 	}
+	return pi, nil
+}
 
-	url := fmt.Sprintf("%s/prove?trusted_height=%d&target_height=%d", zpc.ProverAddress, trustedHeight, targetHeight)
-	resp, err := http.Get(url)
+// VerifyProof checks the proof that is not returned by Prove, e.g. a stored one, in the same way as Prove:
+// the inputs must be the ones computed from the headers at the heights, and the proof must be accepted by the configured verifying key.
+func (zpc ZKProverClient) VerifyProof(trustedHeight uint64, targetHeight uint64, pi *ZKProofAndInput) error {
+	expected, err := zpc.computeInputs(trustedHeight, targetHeight)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	if err := checkInputs(expected, pi.Input); err != nil {
+		return err
+	}
+	return zpc.verifyWithKey(trustedHeight, targetHeight, pi)
+}
+
+// verifyWithKey verifies the proof with the configured verifying key if any
+func (zpc ZKProverClient) verifyWithKey(trustedHeight uint64, targetHeight uint64, pi *ZKProofAndInput) error {
+	verify := zpc.ZKProofVerifier()
+	if verify == nil {
+		return nil
+	}
+	var input [3]*big.Int
+	for i := range pi.Input {
+		input[i] = (*big.Int)(&pi.Input[i])
+	}
+	if err := verify(input, pi.Proof.EncodeEthABI()); err != nil {
+		return fmt.Errorf("trusted_height=%d target_height=%d: %w", trustedHeight, targetHeight, err)
+	}
+	return nil
+}
+
+// LoadVerifyingKey builds the verifier of the configured verifying key, so that an invalid one is found before any proof is generated.
+// It does nothing if the verifying key is not configured.
+func (zpc ZKProverClient) LoadVerifyingKey() error {
+	if zpc.verifier == nil {
+		return nil
+	}
+	_, err := zpc.verifier()
+	return err
+}
+
+// ZKProofVerifier returns the verifier of the configured verifying key, or nil if it is not configured
+func (zpc ZKProverClient) ZKProofVerifier() ZKProofVerifier {
+	if zpc.verifier == nil {
+		return nil
 	}
-	defer resp.Body.Close()
-	var res ZKProofAndInputResponse
-	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+	return func(input [3]*big.Int, proof []byte) error {
+		verify, err := zpc.verifier()
+		if err != nil {
+			return fmt.Errorf("failed to load verifying key: %w", err)
+		}
+		if err := verify(input[:], proof); err != nil {
+			return fmt.Errorf("the zk proof is rejected by the verifying key: %w", err)
+		}
+		return nil
+	}
+}
+
+func (zpc ZKProverClient) prove(trustedHeight uint64, targetHeight uint64) (*ZKProofAndInput, error) {
+	ps, err := zkp.Get(zpc.ProverType)
+	if err != nil {
 		return nil, err
 	}
-	in, err := zpc.proveMock(trustedHeight, targetHeight)
+	expected, err := zpc.computeInputs(trustedHeight, targetHeight)
 	if err != nil {
 		return nil, err
 	}
-	switch zpc.ProverType {
-	case groth16.Groth16ProverType:
-		p, err := groth16.ParseGroth16Proof(res.Proof)
+
+	var res ZKProofAndInput
+	if ps.Prove != nil {
+		input := make([]*big.Int, len(expected))
+		for i := range expected {
+			input[i] = (*big.Int)(&expected[i])
+		}
+		p, proofInput, err := ps.Prove(zkp.ProveRequest{
+			TrustedHeight: trustedHeight,
+			TargetHeight:  targetHeight,
+			Input:         input,
+			ProverAddress: zpc.ProverAddress,
+			DataDir:       zpc.GnarkDataDir,
+		})
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse proof: data=%v err=%w", string(res.Proof), err)
+			return nil, err
 		}
-		for i := range in.Input {
-			if !bytes.Equal(in.Input[i].Bytes(), res.Input[i].Bytes()) {
-				return nil, fmt.Errorf("input mismatch(%v): expected=%v actual=%v", i, in.Input[i], res.Input[i])
-			}
+		if len(proofInput) != len(res.Input) {
+			return nil, fmt.Errorf("invalid number of public inputs: %v", len(proofInput))
 		}
-		return &ZKProofAndInput{
-			Input: res.Input,
-			Proof: p,
-		}, nil
-	case groth16.Groth16CommitmentProverType:
-		cp, err := groth16.ParseGroth16CommitmentProof(res.Proof)
+		for i := range proofInput {
+			res.Input[i] = HexBigInt(*proofInput[i])
+		}
+		res.Proof = p
+	} else {
+		url := fmt.Sprintf("%s/prove?trusted_height=%d&target_height=%d", zpc.ProverAddress, trustedHeight, targetHeight)
+		resp, err := http.Get(url)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse proof: data=%v err=%w", string(res.Proof), err)
+			return nil, err
+		}
+		defer resp.Body.Close()
+		var r ZKProofAndInputResponse
+		if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+			return nil, err
 		}
-		for i := range in.Input {
-			if !bytes.Equal(in.Input[i].Bytes(), res.Input[i].Bytes()) {
-				return nil, fmt.Errorf("input mismatch(%v): expected=%v actual=%v", i, in.Input[i], res.Input[i])
-			}
+		p, err := ps.ParseProof(r.Proof)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse proof: data=%v err=%w", string(r.Proof), err)
 		}
-		return &ZKProofAndInput{
-			Input: res.Input,
-			Proof: cp,
-		}, nil
-	default:
-		return nil, fmt.Errorf("unsupported proof type: %s", zpc.ProverType)
+		res.Input, res.Proof = r.Input, p
+	}
+	if err := checkInputs(expected, res.Input); err != nil {
+		return nil, err
 	}
+	return &res, nil
 }
 
 func int64Ptr(i uint64) *int64 {
@@ -88,51 +185,30 @@ func int64Ptr(i uint64) *int64 {
 	return &i64
 }
 
-func (zpc ZKProverClient) proveMock(trustedHeight uint64, targetHeight uint64) (*ZKProofAndInput, error) {
+// computeInputs returns the public inputs computed from the headers at the heights
+func (zpc ZKProverClient) computeInputs(trustedHeight uint64, targetHeight uint64) ([3]HexBigInt, error) {
+	var res [3]HexBigInt
 	if trustedHeight >= targetHeight {
-		return nil, fmt.Errorf("trustedHeight(%d) should be less than targetHeight(%d)", trustedHeight, targetHeight)
+		return res, fmt.Errorf("trustedHeight(%d) should be less than targetHeight(%d)", trustedHeight, targetHeight)
 	}
 
-	var input [3][32]byte
-
-	res, err := zpc.TMClient.Header(context.TODO(), int64Ptr(targetHeight))
+	h, err := zpc.TMClient.Header(context.TODO(), int64Ptr(targetHeight))
 	if err != nil {
-		return nil, err
+		return res, err
 	}
-	var input2 [32]byte
-	copy(input2[:], res.Header.Hash())
-	input[2] = sha256.Sum256(input2[:])
-	input[2][0] &= 0x1f
+	untrustedBlockHash := h.Header.Hash()
 
-	res, err = zpc.TMClient.Header(context.TODO(), int64Ptr(trustedHeight))
+	h, err = zpc.TMClient.Header(context.TODO(), int64Ptr(trustedHeight))
 	if err != nil {
-		return nil, err
-	}
-	trustedBlockHash := res.Header.Hash()
-
-	if targetHeight-trustedHeight == 1 {
-		copy(input[0][:], zpc.StepVerifierDigest)
-		var input1 [40]byte
-		binary.BigEndian.PutUint64(input1[:8], trustedHeight)
-		copy(input1[8:], trustedBlockHash)
-		input[1] = sha256.Sum256(input1[:])
-		input[1][0] &= 0x1f
-	} else {
-		copy(input[0][:], zpc.SkipVerifierDigest)
-		var input1 [48]byte
-		binary.BigEndian.PutUint64(input1[:8], trustedHeight)
-		copy(input1[8:], trustedBlockHash)
-		binary.BigEndian.PutUint64(input1[40:], targetHeight)
-		input[1] = sha256.Sum256(input1[:])
-		input[1][0] &= 0x1f
+		return res, err
 	}
+	trustedBlockHash := h.Header.Hash()
 
-	var pi ZKProofAndInput
+	input := inputs.Compute(zpc.StepVerifierDigest, zpc.SkipVerifierDigest, trustedHeight, trustedBlockHash, targetHeight, untrustedBlockHash)
 	for i := range input {
-		pi.Input[i] = HexBigInt(*new(big.Int).SetBytes(input[i][:]))
+		res[i] = HexBigInt(*new(big.Int).SetBytes(input[i][:]))
 	}
-	pi.Proof = mock.GetMockProof()
-	return &pi, nil
+	return res, nil
 }
 
 func (zpc ZKProverClient) AsyncProve(trustedHeight uint64, targetHeight uint64) <-chan *ZKProofAndInput {
@@ -0,0 +1,301 @@
+package relay
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/consensys/gnark-crypto/ecc"
+	gnarkgroth16 "github.com/consensys/gnark/backend/groth16"
+	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/datachainlab/tendermint-zk-ibc/go/inputs"
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp"
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp/groth16"
+	"github.com/datachainlab/tendermint-zk-ibc/go/relay/zkp/mock"
+	"github.com/hyperledger-labs/yui-relayer/chains/tendermint"
+)
+
+// testGroth16Circuit has the same number of public inputs as the step and skip circuits
+type testGroth16Circuit struct {
+	VerifierDigest, InputHash, OutputHash frontend.Variable `gnark:",public"`
+	S                                     frontend.Variable
+}
+
+func (c *testGroth16Circuit) Define(api frontend.API) error {
+	api.AssertIsEqual(api.Mul(c.S, c.S), api.Add(c.VerifierDigest, c.InputHash, c.OutputHash))
+	return nil
+}
+
+// newTestGroth16VerifyingKey runs the setup of `testGroth16Circuit`, writes the verifying key to a file and returns its path and the ABI-encoded proof for the public inputs 1, 2 and 6
+func newTestGroth16VerifyingKey(t *testing.T) (string, [3]*big.Int, []byte) {
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &testGroth16Circuit{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk, vk, err := gnarkgroth16.Setup(ccs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := frontend.NewWitness(&testGroth16Circuit{VerifierDigest: 1, InputHash: 2, OutputHash: 6, S: 3}, ecc.BN254.ScalarField())
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof, err := gnarkgroth16.Prove(ccs, pk, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := groth16.ParseGroth16Proof(proof.(*groth16bn254.Proof).MarshalSolidity())
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Create(filepath.Join(t.TempDir(), "vk.bin"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := vk.WriteTo(f); err != nil {
+		t.Fatal(err)
+	}
+	return f.Name(), [3]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(6)}, p.EncodeEthABI()
+}
+
+// marshalSolidityGroth16Proof returns the ABI-encoded proof in the format of gnark's `Proof.MarshalSolidity`, which the provers respond with
+func marshalSolidityGroth16Proof(t *testing.T, proof []byte) []byte {
+	p, err := groth16.EthABIDecodeGroth16Proof(proof)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var bz []byte
+	for _, e := range p {
+		bz = append(bz, e.FillBytes(make([]byte, 32))...)
+	}
+	return bz
+}
+
+func TestZKProofVerifier(t *testing.T) {
+	path, input, proof := newTestGroth16VerifyingKey(t)
+	verify, err := newZKProofVerifier(groth16.Groth16ProverType, path)()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(input[:], proof); err != nil {
+		t.Fatal(err)
+	}
+	if err := verify([]*big.Int{input[0], input[1], big.NewInt(7)}, proof); err == nil {
+		t.Error("expected an error for a wrong input")
+	}
+
+	// the proof is rejected by the verifying key of another setup
+	otherPath, _, otherProof := newTestGroth16VerifyingKey(t)
+	otherVerify, err := newZKProofVerifier(groth16.Groth16ProverType, otherPath)()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := otherVerify(input[:], proof); err == nil {
+		t.Error("expected an error for the verifying key of another setup")
+	}
+	if err := otherVerify(input[:], otherProof); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := newZKProofVerifier(groth16.Groth16ProverType, filepath.Join(t.TempDir(), "missing"))(); err == nil {
+		t.Error("expected an error for a missing verifying key")
+	}
+	empty := filepath.Join(t.TempDir(), "empty")
+	if err := os.WriteFile(empty, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newZKProofVerifier(groth16.Groth16ProverType, empty)(); err == nil {
+		t.Error("expected an error for an empty verifying key")
+	}
+	if _, err := newZKProofVerifier("plonk", path)(); err == nil {
+		t.Error("expected an error for an unknown prover type")
+	}
+}
+
+func TestZKProverClientZKProofVerifier(t *testing.T) {
+	if v := NewZKProverClient(groth16.Groth16ProverType, "", "", "", nil, nil, nil).ZKProofVerifier(); v != nil {
+		t.Error("expected no verifier without a verifying key")
+	}
+
+	path, input, proof := newTestGroth16VerifyingKey(t)
+	zpc := NewZKProverClient(groth16.Groth16ProverType, "", "", path, nil, nil, nil)
+	v := NewUpdateStateValidator(ProverConfig{TrustingPeriod: "1h", VerifyingKeyPath: path}, zpc.ZKProofVerifier())
+	if v.VerifyZKProof == nil {
+		t.Fatal("expected the verifier of the verifying key")
+	}
+	if err := v.VerifyZKProof(input, proof); err != nil {
+		t.Fatal(err)
+	}
+	if err := v.VerifyZKProof([3]*big.Int{input[0], input[1], big.NewInt(7)}, proof); err == nil {
+		t.Error("expected an error for wrong inputs")
+	}
+}
+
+func TestProverInitLoadsVerifyingKey(t *testing.T) {
+	path, _, _ := newTestGroth16VerifyingKey(t)
+	empty := filepath.Join(t.TempDir(), "empty")
+	if err := os.WriteFile(empty, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name             string
+		proverType       string
+		verifyingKeyPath string
+		ok               bool
+	}{
+		{"no verifying key", groth16.Groth16ProverType, "", true},
+		{"valid verifying key", groth16.Groth16ProverType, path, true},
+		{"missing verifying key", groth16.Groth16ProverType, filepath.Join(t.TempDir(), "missing"), false},
+		{"empty verifying key", groth16.Groth16ProverType, empty, false},
+		{"prover type without a native verifier", mock.MockProverType, path, false},
+	}
+	for _, c := range cases {
+		config := ProverConfig{ProverType: c.proverType, VerifyingKeyPath: c.verifyingKeyPath}
+		pr := NewProver(&tendermint.Chain{}, config)
+		if err := pr.Init("", 0, nil, false); c.ok != (err == nil) {
+			t.Errorf("%s: unexpected result: %v", c.name, err)
+		}
+	}
+}
+
+// testHeaderClient serves the headers of `newTestHeader` at any height
+type testHeaderClient struct {
+	rpcclient.Client
+}
+
+func (c testHeaderClient) Header(_ context.Context, height *int64) (*ctypes.ResultHeader, error) {
+	h := newTestHeader()
+	h.Height = *height
+	return &ctypes.ResultHeader{Header: h}, nil
+}
+
+func newTestZKProverClient(proverType string, addr string) ZKProverClient {
+	return NewZKProverClient(proverType, addr, "", "", []byte{1}, []byte{2}, testHeaderClient{})
+}
+
+// testInputs returns the public inputs of the test headers at the heights
+func testInputs(zpc ZKProverClient, trustedHeight, targetHeight int64) [3]HexBigInt {
+	header := func(height int64) []byte {
+		res, _ := zpc.TMClient.Header(context.TODO(), &height)
+		return res.Header.Hash()
+	}
+	var res [3]HexBigInt
+	for i, in := range inputs.Compute(zpc.StepVerifierDigest, zpc.SkipVerifierDigest, uint64(trustedHeight), header(trustedHeight), uint64(targetHeight), header(targetHeight)) {
+		res[i] = HexBigInt(*new(big.Int).SetBytes(in[:]))
+	}
+	return res
+}
+
+var testWrongInputProverType = "test-wrong-input"
+
+func init() {
+	zkp.Register(zkp.ProvingSystem{
+		Name:         testWrongInputProverType,
+		ParseProof:   func(bz []byte) (zkp.ZKProof, error) { return mock.GetMockProof(), nil },
+		DecodeEthABI: func(bz []byte) (zkp.ZKProof, error) { return mock.GetMockProof(), nil },
+		Prove: func(req zkp.ProveRequest) (zkp.ZKProof, []*big.Int, error) {
+			input := append([]*big.Int{new(big.Int).Add(req.Input[0], big.NewInt(1))}, req.Input[1:]...)
+			return mock.GetMockProof(), input, nil
+		},
+	})
+}
+
+func TestZKProverClientProve(t *testing.T) {
+	for _, proverType := range []string{mock.MockProverType, mock.MockGroth16ProverType} {
+		zpc := newTestZKProverClient(proverType, "")
+		pi, err := zpc.Prove(10, 20)
+		if err != nil {
+			t.Fatalf("%s: %v", proverType, err)
+		}
+		if pi.Proof.ProverType() != proverType {
+			t.Errorf("%s: unexpected prover type: %v", proverType, pi.Proof.ProverType())
+		}
+		if err := checkInputs(testInputs(zpc, 10, 20), pi.Input); err != nil {
+			t.Errorf("%s: unexpected inputs: %v", proverType, err)
+		}
+	}
+
+	if _, err := newTestZKProverClient(mock.MockProverType, "").Prove(20, 20); err == nil {
+		t.Error("expected an error for the target height not greater than the trusted height")
+	}
+	if _, err := newTestZKProverClient(testWrongInputProverType, "").Prove(10, 20); err == nil {
+		t.Error("expected an error for the inputs that do not match the headers")
+	}
+	if _, err := newTestZKProverClient("plonk", "").Prove(10, 20); err == nil {
+		t.Error("expected an error for an unknown prover type")
+	}
+}
+
+func TestZKProverClientProveRemote(t *testing.T) {
+	path, _, proof := newTestGroth16VerifyingKey(t)
+	solidityProof := marshalSolidityGroth16Proof(t, proof)
+
+	var input [3]HexBigInt
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/prove" || r.URL.Query().Get("trusted_height") != "10" || r.URL.Query().Get("target_height") != "20" {
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(ZKProofAndInputResponse{Input: input, Proof: solidityProof})
+	}))
+	defer srv.Close()
+
+	zpc := newTestZKProverClient(groth16.Groth16ProverType, srv.URL)
+	input = testInputs(zpc, 10, 20)
+	pi, err := zpc.Prove(10, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if checkInputs(input, pi.Input) != nil || pi.Proof.ProverType() != groth16.Groth16ProverType {
+		t.Errorf("unexpected proof: %v", pi)
+	}
+
+	// the proof of the test circuit is rejected by the configured verifying key if the inputs are not the ones of the circuit
+	zpc.verifier = newZKProofVerifier(groth16.Groth16ProverType, path)
+	if _, err := zpc.Prove(10, 20); err == nil {
+		t.Error("expected an error for the proof rejected by the verifying key")
+	}
+
+	input[0] = HexBigInt(*big.NewInt(1))
+	zpc.verifier = nil
+	if _, err := zpc.Prove(10, 20); err == nil {
+		t.Error("expected an error for the inputs that do not match the headers")
+	}
+}
+
+func TestZKProverClientVerifyProof(t *testing.T) {
+	zpc := newTestZKProverClient(mock.MockProverType, "")
+	pi := &ZKProofAndInput{Input: testInputs(zpc, 10, 20), Proof: mock.GetMockProof()}
+	if err := zpc.VerifyProof(10, 20, pi); err != nil {
+		t.Fatal(err)
+	}
+	if err := zpc.VerifyProof(10, 21, pi); err == nil {
+		t.Error("expected an error for the inputs of other heights")
+	}
+	if err := zpc.VerifyProof(20, 20, pi); err == nil {
+		t.Error("expected an error for the target height not greater than the trusted height")
+	}
+
+	// the proof whose inputs match the headers is still rejected by the configured verifying key
+	path, _, proof := newTestGroth16VerifyingKey(t)
+	p, err := groth16.EthABIDecodeGroth16Proof(proof)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zpc = newTestZKProverClient(groth16.Groth16ProverType, "")
+	zpc.verifier = newZKProofVerifier(groth16.Groth16ProverType, path)
+	if err := zpc.VerifyProof(10, 20, &ZKProofAndInput{Input: testInputs(zpc, 10, 20), Proof: p}); err == nil {
+		t.Error("expected an error for the proof rejected by the verifying key")
+	}
+}
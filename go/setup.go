@@ -11,6 +11,7 @@ import (
 	"github.com/consensys/gnark/frontend/cs/r1cs"
 	"github.com/consensys/gnark/logger"
 	"github.com/consensys/gnark/profile"
+	"github.com/datachainlab/tendermint-zk-ibc/go/wrapper"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/succinctlabs/gnark-plonky2-verifier/types"
@@ -89,7 +90,7 @@ func setup(ps ProvingSystem, dataDir string, dummyDataDir string) error {
 		os.Unsetenv("USE_BIT_DECOMPOSITION_RANGE_CHECK")
 	}
 
-	var circuit Plonky2xVerifierCircuit
+	var circuit wrapper.Plonky2xVerifierCircuit
 	circuit.ProofWithPis = variables.DeserializeProofWithPublicInputs(types.ReadProofWithPublicInputs(dummyDataDir + "/proof_with_public_inputs.json"))
 	circuit.VerifierData = variables.DeserializeVerifierOnlyCircuitData(types.ReadVerifierOnlyCircuitData(dummyDataDir + "/verifier_only_circuit_data.json"))
 	circuit.CommonCircuitData = types.ReadCommonCircuitData(commonCircuitData(dummyDataDir))
@@ -103,7 +104,7 @@ func setup(ps ProvingSystem, dataDir string, dummyDataDir string) error {
 		os.Exit(1)
 	}
 	log.Info().Msg("Circuit built")
-	f, err := os.Create(r1csPath(dataDir))
+	f, err := os.Create(wrapper.R1CSPath(dataDir))
 	if err != nil {
 		return err
 	}
@@ -126,7 +127,7 @@ func setup(ps ProvingSystem, dataDir string, dummyDataDir string) error {
 	if err != nil {
 		return err
 	}
-	fVK, err := os.Create(verifyingKeyPath(dataDir))
+	fVK, err := os.Create(wrapper.VerifyingKeyPath(dataDir))
 	if err != nil {
 		return err
 	}
@@ -140,25 +141,13 @@ func setup(ps ProvingSystem, dataDir string, dummyDataDir string) error {
 	if err != nil {
 		return err
 	}
-	fPK, _ := os.Create(provingKeyPath(dataDir))
+	fPK, _ := os.Create(wrapper.ProvingKeyPath(dataDir))
 	pk.WriteTo(fPK)
 	fPK.Close()
 
 	return nil
 }
 
-func r1csPath(dataDir string) string {
-	return filepath.Join(dataDir, "r1cs.bin")
-}
-
-func verifyingKeyPath(dataDir string) string {
-	return filepath.Join(dataDir, "vk.bin")
-}
-
-func provingKeyPath(dataDir string) string {
-	return filepath.Join(dataDir, "pk.bin")
-}
-
 func commonCircuitData(dummyDataDir string) string {
 	return filepath.Join(dummyDataDir, "common_circuit_data.json")
 }
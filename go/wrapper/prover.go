@@ -0,0 +1,124 @@
+package wrapper
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark/backend"
+	"github.com/consensys/gnark/backend/groth16"
+	groth16_bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/logger"
+	"github.com/datachainlab/tendermint-zk-ibc/go/inputs"
+	"github.com/succinctlabs/gnark-plonky2-verifier/types"
+	"github.com/succinctlabs/gnark-plonky2-verifier/variables"
+)
+
+const NbPublicInputs = inputs.NbPublicInputs
+
+// ProveRequest is a wrapped plonky2 proof generated by the TendermintX circuits of the Rust prover
+type ProveRequest struct {
+	ProofWithPublicInputs   types.ProofWithPublicInputsRaw   `json:"proofWithPublicInputs"`
+	VerifierOnlyCircuitData types.VerifierOnlyCircuitDataRaw `json:"verifierOnlyCircuitData"`
+}
+
+// InputsAndProof is a Groth16 proof of `Plonky2xVerifierCircuit` encoded by `MarshalSolidity` and its public inputs
+type InputsAndProof struct {
+	Input [NbPublicInputs]*big.Int
+	Proof []byte
+}
+
+// Prover proves the plonky2 proofs with the r1cs and the proving key generated by the setup
+type Prover struct {
+	mu sync.Mutex
+	cs constraint.ConstraintSystem
+	pk groth16.ProvingKey
+}
+
+// NewProver loads the r1cs and the proving key in `dataDir`
+func NewProver(dataDir string) (*Prover, error) {
+	log := logger.Logger()
+	log.Info().Msg("Reading R1CS")
+	f, err := os.Open(R1CSPath(dataDir))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	cs := groth16.NewCS(ecc.BN254)
+	if _, err = cs.ReadFrom(f); err != nil {
+		return nil, err
+	}
+	log.Info().Msg("Reading proving key")
+	fPk, err := os.Open(ProvingKeyPath(dataDir))
+	if err != nil {
+		return nil, err
+	}
+	defer fPk.Close()
+	pk := groth16.NewProvingKey(ecc.BN254)
+	if _, err = pk.UnsafeReadFrom(fPk); err != nil {
+		return nil, err
+	}
+	return &Prover{cs: cs, pk: pk}, nil
+}
+
+// Prove generates a Groth16 proof of the plonky2 proof. The proofs are generated one at a time.
+func (p *Prover) Prove(req ProveRequest) (*InputsAndProof, error) {
+	log := logger.Logger()
+	inputHash, outputHash, err := inputs.DecodePlonky2PublicInputs(req.ProofWithPublicInputs.PublicInputs)
+	if err != nil {
+		return nil, err
+	}
+	verifierOnlyCircuitData := variables.DeserializeVerifierOnlyCircuitData(req.VerifierOnlyCircuitData)
+	assignment := Plonky2xVerifierCircuit{
+		ProofWithPis:   variables.DeserializeProofWithPublicInputs(req.ProofWithPublicInputs),
+		VerifierData:   verifierOnlyCircuitData,
+		VerifierDigest: verifierOnlyCircuitData.CircuitDigest,
+		InputHash:      frontend.Variable(inputHash),
+		OutputHash:     frontend.Variable(outputHash),
+	}
+	log.Info().Msg("Generating witness")
+	witness, err := frontend.NewWitness(&assignment, ecc.BN254.ScalarField())
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	log.Info().Msg("Creating proof")
+	proof, err := groth16.Prove(p.cs, p.pk, witness, backend.WithProverHashToFieldFunction(sha256.New()))
+	if err != nil {
+		return nil, err
+	}
+	publicWitness, err := witness.Public()
+	if err != nil {
+		return nil, err
+	}
+	vector, ok := publicWitness.Vector().(fr.Vector)
+	if !ok || len(vector) != NbPublicInputs {
+		return nil, fmt.Errorf("unexpected public witness: %v", publicWitness.Vector())
+	}
+	var res InputsAndProof
+	for i := range vector {
+		res.Input[i] = vector[i].BigInt(new(big.Int))
+	}
+	res.Proof = proof.(*groth16_bn254.Proof).MarshalSolidity()
+	return &res, nil
+}
+
+func R1CSPath(dataDir string) string {
+	return filepath.Join(dataDir, "r1cs.bin")
+}
+
+func VerifyingKeyPath(dataDir string) string {
+	return filepath.Join(dataDir, "vk.bin")
+}
+
+func ProvingKeyPath(dataDir string) string {
+	return filepath.Join(dataDir, "pk.bin")
+}
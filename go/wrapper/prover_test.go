@@ -0,0 +1,131 @@
+package wrapper
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark/frontend"
+	"github.com/datachainlab/tendermint-zk-ibc/go/inputs"
+	"github.com/succinctlabs/gnark-plonky2-verifier/types"
+	"github.com/succinctlabs/gnark-plonky2-verifier/variables"
+)
+
+const dummyDataDir = "../../artifacts/dummy"
+
+// readDummyProveRequest returns the request of the dummy plonky2 proof in the format of the `/plonky2_proof` response
+func readDummyProveRequest(t *testing.T) ProveRequest {
+	proof, err := os.ReadFile(filepath.Join(dummyDataDir, "proof_with_public_inputs.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifierData, err := os.ReadFile(filepath.Join(dummyDataDir, "verifier_only_circuit_data.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bz, err := json.Marshal(map[string]json.RawMessage{
+		"proofWithPublicInputs":   proof,
+		"verifierOnlyCircuitData": verifierData,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var req ProveRequest
+	if err := json.Unmarshal(bz, &req); err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
+
+func TestProveRequestJSON(t *testing.T) {
+	req := readDummyProveRequest(t)
+	proof := types.ReadProofWithPublicInputs(filepath.Join(dummyDataDir, "proof_with_public_inputs.json"))
+	verifierData := types.ReadVerifierOnlyCircuitData(filepath.Join(dummyDataDir, "verifier_only_circuit_data.json"))
+	if len(req.ProofWithPublicInputs.PublicInputs) != len(proof.PublicInputs) || len(req.ProofWithPublicInputs.PublicInputs) != 64 {
+		t.Fatalf("unexpected public inputs: %v", req.ProofWithPublicInputs.PublicInputs)
+	}
+	for i := range proof.PublicInputs {
+		if req.ProofWithPublicInputs.PublicInputs[i] != proof.PublicInputs[i] {
+			t.Fatalf("unexpected public input[%d]: %v", i, req.ProofWithPublicInputs.PublicInputs[i])
+		}
+	}
+	if req.VerifierOnlyCircuitData.CircuitDigest != verifierData.CircuitDigest {
+		t.Errorf("unexpected circuit digest: %v", req.VerifierOnlyCircuitData.CircuitDigest)
+	}
+}
+
+// TestPublicWitness checks that the public inputs of `Plonky2xVerifierCircuit` are in the order of `inputs.Compute` and the verifier contracts
+func TestPublicWitness(t *testing.T) {
+	req := readDummyProveRequest(t)
+	inputHash, outputHash, err := inputs.DecodePlonky2PublicInputs(req.ProofWithPublicInputs.PublicInputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifierData := variables.DeserializeVerifierOnlyCircuitData(req.VerifierOnlyCircuitData)
+	w, err := frontend.NewWitness(&Plonky2xVerifierCircuit{
+		ProofWithPis:   variables.DeserializeProofWithPublicInputs(req.ProofWithPublicInputs),
+		VerifierData:   verifierData,
+		VerifierDigest: verifierData.CircuitDigest,
+		InputHash:      inputHash,
+		OutputHash:     outputHash,
+	}, ecc.BN254.ScalarField())
+	if err != nil {
+		t.Fatal(err)
+	}
+	public, err := w.Public()
+	if err != nil {
+		t.Fatal(err)
+	}
+	vector, ok := public.Vector().(fr.Vector)
+	if !ok || len(vector) != NbPublicInputs {
+		t.Fatalf("unexpected public witness: %v", public.Vector())
+	}
+	digest, ok := new(big.Int).SetString(req.VerifierOnlyCircuitData.CircuitDigest, 10)
+	if !ok {
+		t.Fatalf("invalid circuit digest: %v", req.VerifierOnlyCircuitData.CircuitDigest)
+	}
+	for i, expected := range []*big.Int{digest, inputHash, outputHash} {
+		if actual := vector[i].BigInt(new(big.Int)); actual.Cmp(expected) != 0 {
+			t.Errorf("unexpected public input[%d]: expected=%v actual=%v", i, expected, actual)
+		}
+	}
+}
+
+func TestNewProver(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := NewProver(dir); err == nil {
+		t.Error("expected an error for a data directory without the setup")
+	}
+	if err := os.WriteFile(R1CSPath(dir), []byte("invalid"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewProver(dir); err == nil {
+		t.Error("expected an error for an invalid r1cs")
+	}
+}
+
+func TestProveInvalidPublicInputs(t *testing.T) {
+	req := readDummyProveRequest(t)
+	cases := []struct {
+		name   string
+		modify func(pis []uint64) []uint64
+	}{
+		{"too few public inputs", func(pis []uint64) []uint64 { return pis[:63] }},
+		{"too many public inputs", func(pis []uint64) []uint64 { return append(pis, 0) }},
+		{"not a byte", func(pis []uint64) []uint64 { pis[0] = 0x100; return pis }},
+		{"input hash over 253 bits", func(pis []uint64) []uint64 { pis[0] = 0xff; return pis }},
+		{"output hash over 253 bits", func(pis []uint64) []uint64 { pis[32] = 0xff; return pis }},
+	}
+	for _, c := range cases {
+		r := req
+		r.ProofWithPublicInputs.PublicInputs = c.modify(append([]uint64(nil), req.ProofWithPublicInputs.PublicInputs...))
+		// the public inputs are checked before the r1cs and the proving key are used
+		if _, err := new(Prover).Prove(r); err == nil {
+			t.Errorf("%s: expected an error", c.name)
+		}
+	}
+}
@@ -15,7 +15,7 @@ use serde_json::Value;
 use std::env;
 use std::sync::Arc;
 use tendermintx::config::SKIP_MAX;
-use tower::limit::ConcurrencyLimitLayer;
+use tower::limit::GlobalConcurrencyLimitLayer;
 use tower::ServiceBuilder;
 use tower_http::catch_panic::CatchPanicLayer;
 
@@ -71,9 +71,12 @@ impl ServiceCommand {
                 ChainConfig,
             >(),
         });
+        // both endpoints run the same circuits, so they share one proving slot
+        let prove_limit = GlobalConcurrencyLimitLayer::new(1);
         let app = Router::new()
             .route("/health", get(health))
-            .route("/prove", get(prove).layer(ConcurrencyLimitLayer::new(1)))
+            .route("/prove", get(prove).layer(prove_limit.clone()))
+            .route("/plonky2_proof", get(plonky2_proof).layer(prove_limit))
             .with_state(shared_state)
             .layer(
                 ServiceBuilder::new()
@@ -131,10 +134,10 @@ fn prove_skip_circuit(
     Ok(output.wrapped_proof)
 }
 
-async fn prove(
-    State(state): State<Arc<ServiceState>>,
-    Query(params): Query<ProveArgs>,
-) -> Json<Value> {
+async fn prove_wrapped(
+    state: Arc<ServiceState>,
+    params: ProveArgs,
+) -> WrappedOutput<Groth16WrapperParameters, 2> {
     params.validate().unwrap();
 
     let hash =
@@ -163,7 +166,14 @@ async fn prove(
     // get elapsed time
     let elapsed = start.elapsed();
     info!("Elapsed time: {:?}", elapsed);
+    wrapped_proof
+}
 
+async fn prove(
+    State(state): State<Arc<ServiceState>>,
+    Query(params): Query<ProveArgs>,
+) -> Json<Value> {
+    let wrapped_proof = prove_wrapped(state.clone(), params).await;
     let req = ProveRequest::new(&wrapped_proof);
     Json(
         serde_json::from_slice(
@@ -176,6 +186,16 @@ async fn prove(
     )
 }
 
+/// Returns the wrapped plonky2 proof without requesting the gnark verifier.
+/// It is used by the relayer that runs the gnark prover in-process.
+async fn plonky2_proof(
+    State(state): State<Arc<ServiceState>>,
+    Query(params): Query<ProveArgs>,
+) -> Json<Value> {
+    let wrapped_proof = prove_wrapped(state, params).await;
+    Json(serde_json::to_value(ProveRequest::new(&wrapped_proof)).unwrap())
+}
+
 async fn health(State(state): State<Arc<ServiceState>>) -> Json<Value> {
     let res = gnark_verifier::health(&state.gnark_verifier_address).await;
     if res.is_ok() {
@@ -118,7 +118,8 @@ contract Groth16CommitmentVerifierTest is Test {
             skip_verifier_digest: abi.encodePacked(bytes32(skipVerifierDigest)),
             frozen: false,
             trusting_period: 1209600000000000, // 2 weeks in nanoseconds
-            latest_height: Height.Data({revision_number: 0, revision_height: 4})
+            latest_height: Height.Data({revision_number: 0, revision_height: 4}),
+            proof_specs: new uint32[](0)
         });
         ProtoConsensusState.Data memory consensusState = ProtoConsensusState.Data({
             block_hash: abi.encodePacked(bytes32(hex"B0C0176E960C4679C21D4AE5508025D5D4658F8587978786D9B76540F0EF68F3")),
@@ -138,7 +138,8 @@ contract Groth16VerifierTest is Test {
             skip_verifier_digest: abi.encodePacked(bytes32(skipVerifierDigest)),
             frozen: false,
             trusting_period: 1209600000000000, // 2 weeks in nanoseconds
-            latest_height: Height.Data({revision_number: 0, revision_height: 71})
+            latest_height: Height.Data({revision_number: 0, revision_height: 71}),
+            proof_specs: new uint32[](0)
         });
         ProtoConsensusState.Data memory consensusState = ProtoConsensusState.Data({
             block_hash: abi.encodePacked(bytes32(hex"735FD53BF3DB0701830669F7EF935C7287D767C0D5F288E2212545C0B0FAABEC")),
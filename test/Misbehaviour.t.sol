@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: UNLICENSED
+pragma solidity ^0.8.13;
+
+import {Test} from "forge-std/Test.sol";
+import {Height} from "@hyperledger-labs/yui-ibc-solidity/contracts/proto/Client.sol";
+import {GoogleProtobufAny as Any} from "@hyperledger-labs/yui-ibc-solidity/contracts/proto/GoogleProtobufAny.sol";
+import {ILightClient} from "@hyperledger-labs/yui-ibc-solidity/contracts/core/02-client/ILightClient.sol";
+import {TendermintZKLightClientMock} from "../contracts/mock/TendermintZKLightClientMock.sol";
+import {ITendermintZKLightClient} from "../contracts/TendermintZKLightClient.sol";
+import {ITendermintZKLightClientErrors} from "../contracts/ITendermintZKLightClientErrors.sol";
+import {TendermintHeader} from "../contracts/TendermintHeader.sol";
+import {TendermintZKPublicInputs} from "../contracts/TendermintZKPublicInputs.sol";
+import {
+    IbcLightclientsTendermintzkV1ClientState as ProtoClientState,
+    IbcLightclientsTendermintzkV1ConsensusState as ProtoConsensusState,
+    IbcLightclientsTendermintzkV1UpdateStateMessage as ProtoUpdateStateMessage,
+    IbcLightclientsTendermintzkV1Misbehaviour as ProtoMisbehaviour
+} from "../contracts/proto/ibc/lightclients/tendermintzk/v1/TendermintZKLightClient.sol";
+import {TendermintZKLightClientProtoMarshaler} from "../contracts/TendermintZKLightClientProtoMarshaler.sol";
+
+contract MisbehaviourTest is Test {
+    string internal constant clientId = "tendermint-zk";
+    uint256 internal immutable stepVerifierDigest =
+        uint256(bytes32(hex"09bf185e9e478bac323981a844afe484dcd73823f6a34f5adb8cffe6c4436111"));
+    uint256 internal immutable skipVerifierDigest =
+        uint256(bytes32(hex"286fd609266936f71d552671b7553f1a0e59c7cf296112996bded1ca3bafa4a4"));
+    bytes32 internal constant trustedBlockHash = hex"735FD53BF3DB0701830669F7EF935C7287D767C0D5F288E2212545C0B0FAABEC";
+
+    TendermintZKLightClientMock public lc;
+
+    function setUp() public {
+        lc = new TendermintZKLightClientMock(address(this), stepVerifierDigest, skipVerifierDigest, 0);
+        initializeClient();
+    }
+
+    function test_submitMisbehaviour() public {
+        ITendermintZKLightClient.UpdateStateInput memory m1 = skipUpdateStateInput(bytes32(uint256(1)));
+        ITendermintZKLightClient.UpdateStateInput memory m2 = skipUpdateStateInput(bytes32(uint256(2)));
+        vm.warp(m1.timestamp + 100_000_000);
+        assertEq(uint8(lc.getStatus(clientId)), uint8(ILightClient.ClientStatus.Active));
+
+        Height.Data[] memory heights = lc.submitMisbehaviour(clientId, m1, "mock", m2, "mock");
+        assertEq(heights.length, 0, "unexpected heights");
+        assertEq(uint8(lc.getStatus(clientId)), uint8(ILightClient.ClientStatus.Frozen));
+
+        vm.expectRevert(ITendermintZKLightClientErrors.ITendermintZKLightClientClientStateFrozen.selector);
+        lc.updateStateMock(clientId, m1, "mock");
+        vm.expectRevert(ITendermintZKLightClientErrors.ITendermintZKLightClientClientStateFrozen.selector);
+        lc.submitMisbehaviour(clientId, m1, "mock", m2, "mock");
+    }
+
+    function test_submitMisbehaviour_invalid() public {
+        ITendermintZKLightClient.UpdateStateInput memory m1 = skipUpdateStateInput(bytes32(uint256(1)));
+        ITendermintZKLightClient.UpdateStateInput memory m2 = skipUpdateStateInput(bytes32(uint256(2)));
+        vm.warp(m1.timestamp + 100_000_000);
+
+        vm.expectRevert(ITendermintZKLightClientErrors.ITendermintZKLightClientInvalidMisbehaviourBlockHash.selector);
+        lc.submitMisbehaviour(clientId, m1, "mock", m1, "mock");
+
+        vm.expectRevert("proof not valid");
+        lc.submitMisbehaviour(clientId, m1, "mock", m2, "invalid");
+
+        ITendermintZKLightClient.UpdateStateInput memory m3 = skipUpdateStateInput(bytes32(uint256(2)));
+        m3.untrustedHeight = 158;
+        vm.expectRevert(ITendermintZKLightClientErrors.ITendermintZKLightClientInvalidMisbehaviourHeight.selector);
+        lc.submitMisbehaviour(clientId, m1, "mock", m3, "mock");
+
+        // the output must commit to the block hash
+        m2.input[2] = m1.input[2];
+        vm.expectRevert(ITendermintZKLightClientErrors.ITendermintZKLightClientZKProofUnexpectedOutput.selector);
+        lc.submitMisbehaviour(clientId, m1, "mock", m2, "mock");
+
+        assertEq(uint8(lc.getStatus(clientId)), uint8(ILightClient.ClientStatus.Active));
+    }
+
+    function test_updateState_conflictingConsensusState() public {
+        ITendermintZKLightClient.UpdateStateInput memory m1 = skipUpdateStateInput(bytes32(uint256(1)));
+        ITendermintZKLightClient.UpdateStateInput memory m2 = skipUpdateStateInput(bytes32(uint256(2)));
+        vm.warp(m1.timestamp + 100_000_000);
+
+        assertEq(lc.updateStateMock(clientId, m1, "mock").length, 1);
+        // the same block again is a no-op
+        assertEq(lc.updateStateMock(clientId, m1, "mock").length, 0);
+        assertEq(uint8(lc.getStatus(clientId)), uint8(ILightClient.ClientStatus.Active));
+
+        assertEq(lc.updateStateMock(clientId, m2, "mock").length, 0);
+        assertEq(uint8(lc.getStatus(clientId)), uint8(ILightClient.ClientStatus.Frozen));
+        (bytes memory consensusState,) =
+            lc.getConsensusState(clientId, Height.Data({revision_number: 0, revision_height: 157}));
+        assertEq(
+            consensusState,
+            TendermintZKLightClientProtoMarshaler.marshalConsensusState(m1.untrustedBlockHash, m1.appHash, m1.timestamp),
+            "the consensus state must not be overwritten"
+        );
+    }
+
+    function test_routeUpdateClient_misbehaviour() public {
+        ITendermintZKLightClient.UpdateStateInput memory m1 = skipUpdateStateInput(bytes32(uint256(1)));
+        ITendermintZKLightClient.UpdateStateInput memory m2 = skipUpdateStateInput(bytes32(uint256(2)));
+        vm.warp(m1.timestamp + 100_000_000);
+
+        bytes memory protoMisbehaviour = Any.encode(
+            Any.Data({
+                type_url: "/ibc.lightclients.tendermintzk.v1.Misbehaviour",
+                value: ProtoMisbehaviour.encode(
+                    ProtoMisbehaviour.Data({update_state_1: toProto(m1, "mock"), update_state_2: toProto(m2, "mock")})
+                )
+            })
+        );
+        (bytes4 selector, bytes memory args) = lc.routeUpdateClient(clientId, protoMisbehaviour);
+        assertEq(selector, lc.submitMisbehaviour.selector, "unexpected selector");
+        (bool success,) = address(lc).call(abi.encodePacked(selector, args));
+        assertTrue(success, "failed to submit the misbehaviour");
+        assertEq(uint8(lc.getStatus(clientId)), uint8(ILightClient.ClientStatus.Frozen));
+    }
+
+    // ---------------------------- Intenal functions ----------------------------
+
+    function initializeClient() internal {
+        ProtoClientState.Data memory clientState = ProtoClientState.Data({
+            step_verifier_digest: abi.encodePacked(bytes32(stepVerifierDigest)),
+            skip_verifier_digest: abi.encodePacked(bytes32(skipVerifierDigest)),
+            frozen: false,
+            trusting_period: 1209600000000000, // 2 weeks in nanoseconds
+            latest_height: Height.Data({revision_number: 0, revision_height: 71}),
+            proof_specs: new uint32[](0)
+        });
+        ProtoConsensusState.Data memory consensusState = ProtoConsensusState.Data({
+            block_hash: abi.encodePacked(trustedBlockHash),
+            app_hash: abi.encodePacked(bytes32(hex"665700d55a782e879cf6bec2ff238970df23553473f5b99405337597d4f4449c")),
+            timestamp: 1713799218801174900
+        });
+
+        lc.initializeClient(
+            clientId,
+            TendermintZKLightClientProtoMarshaler.marshal(clientState),
+            TendermintZKLightClientProtoMarshaler.marshal(consensusState)
+        );
+    }
+
+    /// @dev returns a message of a skip from 71 to 157 whose block has `appHash`
+    function skipUpdateStateInput(bytes32 appHash)
+        internal
+        view
+        returns (ITendermintZKLightClient.UpdateStateInput memory m)
+    {
+        m.trustedHeight = 71;
+        m.untrustedHeight = 157;
+        m.timestamp = 1713799305285796610;
+        m.appHash = appHash;
+        m.simpleTreeProof = [
+            bytes32(hex"562d84b15d6b3272a6e48b940b55afbd0e440c7af3266a8b92aafa2e2b8df5b1"),
+            bytes32(hex"55fc96a99b65e8eb50a7691fa9b2d8f20f3afd951b77ea14ba7dcca5a8447ad0"),
+            bytes32(hex"951da166ea46111da5bad0515cf715edd4eed23e10bf2e342c6286de4b989720"),
+            bytes32(hex"25256014ce4ba2961128a3cdb666270b2a0d052b96ddfbd8f6338778c9edce0d"),
+            bytes32(hex"4ef01b65f45d1291a2c81098282366484ba3b71d0091c0c599a5db5ea6e55eaa"),
+            bytes32(hex"9fb9c7533caf1d218da3af6d277f6b101c42e3c3b75d784242da663604dd53c2")
+        ];
+        m.untrustedBlockHash = TendermintHeader.merkleRoot2(m.timestamp, m.appHash, m.simpleTreeProof);
+        m.input = [
+            skipVerifierDigest,
+            TendermintZKPublicInputs.skipInputHash(m.trustedHeight, trustedBlockHash, m.untrustedHeight),
+            TendermintZKPublicInputs.outputHash(m.untrustedBlockHash)
+        ];
+    }
+
+    function toProto(ITendermintZKLightClient.UpdateStateInput memory m, bytes memory zkp)
+        internal
+        pure
+        returns (ProtoUpdateStateMessage.Data memory message)
+    {
+        message.trusted_height = m.trustedHeight;
+        message.untrusted_height = m.untrustedHeight;
+        message.untrusted_block_hash = abi.encodePacked(m.untrustedBlockHash);
+        message.timestamp = m.timestamp;
+        message.app_hash = abi.encodePacked(m.appHash);
+        message.simple_tree_proof = new bytes[](6);
+        for (uint256 i = 0; i < 6; i++) {
+            message.simple_tree_proof[i] = abi.encodePacked(m.simpleTreeProof[i]);
+        }
+        message.input = new bytes[](3);
+        for (uint256 i = 0; i < 3; i++) {
+            message.input[i] = abi.encodePacked(m.input[i]);
+        }
+        message.zk_proof = zkp;
+    }
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: UNLICENSED
+pragma solidity ^0.8.13;
+
+import {Test} from "forge-std/Test.sol";
+import {TendermintZKPublicInputs} from "../contracts/TendermintZKPublicInputs.sol";
+
+/// @dev The vectors in `test/data/public_inputs.json` are shared with the `inputs` package of the relayer and the gnark prover.
+/// The hashes are computed by `TendermintZKPublicInputs`, which `TendermintZKLightClient.updateState` uses.
+contract PublicInputsTest is Test {
+    // the fields are sorted alphabetically as `vm.parseJson` requires
+    struct Vector {
+        bytes32 inputHash;
+        string name;
+        bytes32 outputHash;
+        uint256 targetHeight;
+        bytes32 trustedBlockHash;
+        uint256 trustedHeight;
+        bytes32 untrustedBlockHash;
+    }
+
+    function test_publicInputs() public {
+        string memory data = vm.readFile("./test/data/public_inputs.json");
+        Vector[] memory vectors = abi.decode(vm.parseJson(data, ".vectors"), (Vector[]));
+        assertGt(vectors.length, 0, "no test vectors");
+        for (uint256 i = 0; i < vectors.length; i++) {
+            Vector memory v = vectors[i];
+            uint64 trustedHeight = uint64(v.trustedHeight);
+            uint64 targetHeight = uint64(v.targetHeight);
+            assertEq(
+                TendermintZKPublicInputs.inputHash(trustedHeight, v.trustedBlockHash, targetHeight),
+                uint256(v.inputHash),
+                string.concat("invalid input hash: ", v.name)
+            );
+            if (targetHeight - trustedHeight == 1) {
+                assertEq(
+                    TendermintZKPublicInputs.stepInputHash(trustedHeight, v.trustedBlockHash),
+                    uint256(v.inputHash),
+                    string.concat("invalid step input hash: ", v.name)
+                );
+            } else {
+                assertEq(
+                    TendermintZKPublicInputs.skipInputHash(trustedHeight, v.trustedBlockHash, targetHeight),
+                    uint256(v.inputHash),
+                    string.concat("invalid skip input hash: ", v.name)
+                );
+            }
+            assertEq(
+                TendermintZKPublicInputs.outputHash(v.untrustedBlockHash),
+                uint256(v.outputHash),
+                string.concat("invalid output hash: ", v.name)
+            );
+        }
+    }
+
+    function test_hashesAreTruncated() public {
+        for (uint256 i = 0; i < 16; i++) {
+            bytes32 h = keccak256(abi.encodePacked(i));
+            assertLt(TendermintZKPublicInputs.stepInputHash(uint64(i), h), 1 << 253);
+            assertLt(TendermintZKPublicInputs.skipInputHash(uint64(i), h, uint64(i + 2)), 1 << 253);
+            assertLt(TendermintZKPublicInputs.outputHash(h), 1 << 253);
+        }
+    }
+}
@@ -11,6 +11,7 @@ import {
     IbcLightclientsTendermintzkV1ConsensusState as ProtoConsensusState
 } from "../contracts/proto/ibc/lightclients/tendermintzk/v1/TendermintZKLightClient.sol";
 import {TendermintZKLightClientProtoMarshaler} from "../contracts/TendermintZKLightClientProtoMarshaler.sol";
+import {ITendermintZKLightClientErrors} from "../contracts/ITendermintZKLightClientErrors.sol";
 
 contract VerifyMembershipTest is Test {
     uint256 internal immutable stepVerifierDigest =
@@ -105,28 +106,43 @@ contract VerifyMembershipTest is Test {
         assertEq(v, root);
     }
 
-    function test_verifyMembership() public {
+    function initializeClient(TendermintZKLightClientMock client, bytes32 appHash)
+        internal
+        returns (Height.Data memory)
+    {
+        return initializeClient(client, appHash, new uint32[](0));
+    }
+
+    function initializeClient(TendermintZKLightClientMock client, bytes32 appHash, uint32[] memory proofSpecs)
+        internal
+        returns (Height.Data memory)
+    {
         ProtoClientState.Data memory clientState = ProtoClientState.Data({
             step_verifier_digest: abi.encodePacked(bytes32(stepVerifierDigest)),
             skip_verifier_digest: abi.encodePacked(bytes32(skipVerifierDigest)),
             frozen: false,
             trusting_period: 1209600000000000, // 2 weeks in nanoseconds
-            latest_height: Height.Data({revision_number: 0, revision_height: 4})
+            latest_height: Height.Data({revision_number: 0, revision_height: 4}),
+            proof_specs: proofSpecs
         });
         ProtoConsensusState.Data memory consensusState = ProtoConsensusState.Data({
             block_hash: abi.encodePacked(bytes32(0)),
-            app_hash: abi.encodePacked(bytes32(hex"bb76430c4007e046fe0fa0e81c78e8af308aa60f83893f3f8938a94593b7063e")),
+            app_hash: abi.encodePacked(appHash),
             timestamp: 1711326380725084999
         });
 
-        lc.initializeClient(
+        client.initializeClient(
             "tendermint-zk",
             TendermintZKLightClientProtoMarshaler.marshal(clientState),
             TendermintZKLightClientProtoMarshaler.marshal(consensusState)
         );
+        return clientState.latest_height;
+    }
+
+    /// @dev returns the proofs of the channel end of channel-0 in the IBC store of an IAVL tree
+    function channelExistenceProofs() internal pure returns (TV.ExistenceProof[2] memory eproof, bytes memory value) {
         // serialized channel state
-        bytes memory value = hex"080110011a0a0a087472616e73666572220c636f6e6e656374696f6e2d302a0769637332302d31";
-        TV.ExistenceProof[2] memory eproof;
+        value = hex"080110011a0a0a087472616e73666572220c636f6e6e656374696f6e2d302a0769637332302d31";
         {
             eproof[0] = TV.ExistenceProof({
                 spec: TV.ProofSpec.IAVLTree,
@@ -165,10 +181,72 @@ contract VerifyMembershipTest is Test {
             eproof[1].path[4] =
                 TV.InnerOp(hex"01", hex"30ee99c3a68506ac7ba9cee895e3341f921cf0042f62b0e5bf440affc8ce52cb");
         }
+    }
+
+    /// @dev returns the proofs of the client state of 07-tendermint-0 in the IBC store of a sparse merkle tree
+    /// The vector is generated with ics23.SmtSpec and ics23.TendermintSpec.
+    function smtExistenceProofs() internal pure returns (TV.ExistenceProof[2] memory eproof, bytes memory value) {
+        value = bytes("client");
+        eproof[0] = TV.ExistenceProof({
+            spec: TV.ProofSpec.SparseMerkleTree,
+            prefix: hex"00",
+            key: bytes("clients/07-tendermint-0/clientState"),
+            value: value,
+            path: new TV.InnerOp[](2)
+        });
+        eproof[0].path[0] = TV.InnerOp(hex"01", hex"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa");
+        eproof[0].path[1] = TV.InnerOp(hex"01bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", hex"");
+        eproof[1] = TV.ExistenceProof({
+            spec: TV.ProofSpec.SimpleTree,
+            prefix: hex"00",
+            key: bytes("ibc"),
+            value: hex"5ba4089abe3c747d75da805e1b99df966d2e6cec2907bd781f8f757bd5a6083b",
+            path: new TV.InnerOp[](2)
+        });
+        eproof[1].path[0] = TV.InnerOp(hex"01", hex"cccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc");
+        eproof[1].path[1] = TV.InnerOp(hex"01dddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddd", hex"");
+    }
+
+    function test_verifyMembership() public {
+        Height.Data memory height =
+            initializeClient(lc, hex"bb76430c4007e046fe0fa0e81c78e8af308aa60f83893f3f8938a94593b7063e");
+        (TV.ExistenceProof[2] memory eproof, bytes memory value) = channelExistenceProofs();
+        lc.verifyMembership(
+            "tendermint-zk",
+            height,
+            0,
+            0,
+            abi.encode(eproof),
+            bytes("ibc"),
+            bytes("channelEnds/ports/transfer/channels/channel-0"),
+            value
+        );
+    }
+
+    function test_verifyMembershipUnexpectedSpec() public {
+        Height.Data memory height =
+            initializeClient(lc, hex"bb76430c4007e046fe0fa0e81c78e8af308aa60f83893f3f8938a94593b7063e");
+        (TV.ExistenceProof[2] memory eproof, bytes memory value) = channelExistenceProofs();
+        // the prover must not choose the verifier with the spec in the proof
+        eproof[0].spec = TV.ProofSpec.SparseMerkleTree;
+        vm.expectRevert(ITendermintZKLightClientErrors.ITendermintZKLightClientUnexpectedProofSpec.selector);
+        lc.verifyMembership(
+            "tendermint-zk",
+            height,
+            0,
+            0,
+            abi.encode(eproof),
+            bytes("ibc"),
+            bytes("channelEnds/ports/transfer/channels/channel-0"),
+            value
+        );
 
+        (eproof, value) = channelExistenceProofs();
+        eproof[1].spec = TV.ProofSpec.IAVLTree;
+        vm.expectRevert(ITendermintZKLightClientErrors.ITendermintZKLightClientUnexpectedProofSpec.selector);
         lc.verifyMembership(
             "tendermint-zk",
-            clientState.latest_height,
+            height,
             0,
             0,
             abi.encode(eproof),
@@ -177,4 +255,102 @@ contract VerifyMembershipTest is Test {
             value
         );
     }
+
+    function test_verifyMembershipSMTSpec() public {
+        (TV.ExistenceProof[2] memory eproof, bytes memory value) = smtExistenceProofs();
+        bytes32 root = TV.verifyMembershipSMTSpec(eproof[0].prefix, eproof[0].key, value, eproof[0].path);
+        assertEq(root, bytes32(eproof[1].value));
+        // a wrong value changes the root
+        assertTrue(TV.verifyMembershipSMTSpec(eproof[0].prefix, eproof[0].key, bytes("other"), eproof[0].path) != root);
+        // the leaf prefix must be 0x00
+        vm.expectRevert(ITendermintZKLightClientErrors.ITendermintZKLightClientSMTSpecInvalidProof.selector);
+        this.verifyMembershipSMTSpec(hex"01", eproof[0].key, value, eproof[0].path);
+    }
+
+    function verifyMembershipSMTSpec(
+        bytes memory prefix,
+        bytes memory key,
+        bytes memory value,
+        TV.InnerOp[] memory path
+    ) external pure returns (bytes32) {
+        return TV.verifyMembershipSMTSpec(prefix, key, value, path);
+    }
+
+    function test_initializeClientProofSpecs() public {
+        bytes32 appHash = hex"bb76430c4007e046fe0fa0e81c78e8af308aa60f83893f3f8938a94593b7063e";
+        uint32[] memory defaultSpecs = new uint32[](2);
+        defaultSpecs[0] = uint32(TV.ProofSpec.IAVLTree);
+        defaultSpecs[1] = uint32(TV.ProofSpec.SimpleTree);
+
+        // the default specs are omitted in the client state
+        vm.expectRevert(ITendermintZKLightClientErrors.ITendermintZKLightClientInvalidProofSpecs.selector);
+        initializeClient(lc, appHash, defaultSpecs);
+        vm.expectRevert(ITendermintZKLightClientErrors.ITendermintZKLightClientInvalidProofSpecs.selector);
+        initializeClient(lc, appHash, smtProofSpecs());
+
+        TendermintZKLightClientMockSMT smtClient =
+            new TendermintZKLightClientMockSMT(address(this), stepVerifierDigest, skipVerifierDigest, 0);
+        vm.expectRevert(ITendermintZKLightClientErrors.ITendermintZKLightClientInvalidProofSpecs.selector);
+        initializeClient(smtClient, appHash);
+        Height.Data memory height = initializeClient(smtClient, appHash, smtProofSpecs());
+
+        // the client state has the specs of the client
+        (bytes memory anyClientState,) = smtClient.getClientState("tendermint-zk");
+        ProtoClientState.Data memory clientState =
+            TendermintZKLightClientProtoMarshaler.unmarshalClientState(anyClientState);
+        assertEq(keccak256(abi.encodePacked(clientState.proof_specs)), keccak256(abi.encodePacked(smtProofSpecs())));
+        assertEq(height.revision_height, 4);
+    }
+
+    function smtProofSpecs() internal pure returns (uint32[] memory specs) {
+        specs = new uint32[](2);
+        specs[0] = uint32(TV.ProofSpec.SparseMerkleTree);
+        specs[1] = uint32(TV.ProofSpec.SimpleTree);
+    }
+
+    function test_verifyMembershipSMTClient() public {
+        TendermintZKLightClientMockSMT smtClient =
+            new TendermintZKLightClientMockSMT(address(this), stepVerifierDigest, skipVerifierDigest, 0);
+        bytes32 appHash = hex"a6c4731fed1bf5c595aeb423253a3c7fd4075aedf3eb16e8c5bd639e4d3160aa";
+        Height.Data memory height = initializeClient(smtClient, appHash, smtProofSpecs());
+        (TV.ExistenceProof[2] memory eproof, bytes memory value) = smtExistenceProofs();
+        assertTrue(
+            smtClient.verifyMembership(
+                "tendermint-zk",
+                height,
+                0,
+                0,
+                abi.encode(eproof),
+                bytes("ibc"),
+                bytes("clients/07-tendermint-0/clientState"),
+                value
+            )
+        );
+
+        // the default client expects an IAVL proof
+        initializeClient(lc, appHash);
+        vm.expectRevert(ITendermintZKLightClientErrors.ITendermintZKLightClientUnexpectedProofSpec.selector);
+        lc.verifyMembership(
+            "tendermint-zk",
+            height,
+            0,
+            0,
+            abi.encode(eproof),
+            bytes("ibc"),
+            bytes("clients/07-tendermint-0/clientState"),
+            value
+        );
+    }
+}
+
+/// @dev a client of a chain whose IBC store is a sparse merkle tree
+contract TendermintZKLightClientMockSMT is TendermintZKLightClientMock {
+    constructor(address ibcHandler_, uint256 stepVerifierDigest_, uint256 skipVerifierDigest_, uint64 revisionNumber_)
+        TendermintZKLightClientMock(ibcHandler_, stepVerifierDigest_, skipVerifierDigest_, revisionNumber_)
+    {}
+
+    function proofSpecs() internal pure override returns (TV.ProofSpec[2] memory specs) {
+        specs[0] = TV.ProofSpec.SparseMerkleTree;
+        specs[1] = TV.ProofSpec.SimpleTree;
+    }
 }